@@ -0,0 +1,12 @@
+data "graviteeioam_certificates" "example" {
+  organization_id = "DEFAULT"
+  environment_id  = "DEFAULT"
+  domain_id       = graviteeioam_domain.example.domain_id
+}
+
+output "expiring_certificates" {
+  value = [
+    for certificate in data.graviteeioam_certificates.example.certificates : certificate.name
+    if certificate.status == "WILL_EXPIRE" || certificate.status == "EXPIRED"
+  ]
+}
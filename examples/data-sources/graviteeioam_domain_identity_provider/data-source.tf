@@ -0,0 +1,6 @@
+data "graviteeioam_domain_identity_provider" "example" {
+  organization_id      = "DEFAULT"
+  environment_id       = "DEFAULT"
+  domain_id            = graviteeioam_domain.example.domain_id
+  identity_provider_id = "default-idp-${graviteeioam_domain.example.domain_id}"
+}
@@ -0,0 +1,4 @@
+data "graviteeioam_organization_identity_provider" "example" {
+  organization_id      = "DEFAULT"
+  identity_provider_id = "gravitee"
+}
@@ -0,0 +1,20 @@
+data "graviteeioam_scopes" "example" {
+  organization_id = "DEFAULT"
+  environment_id  = "DEFAULT"
+  domain_id       = graviteeioam_domain.example.domain_id
+  query           = "orders"
+}
+
+resource "graviteeioam_application" "orders" {
+  organization_id = "DEFAULT"
+  environment_id  = "DEFAULT"
+  domain_id       = graviteeioam_domain.example.domain_id
+  name            = "Orders backend"
+  type            = "service"
+
+  scope_settings = [
+    for key in data.graviteeioam_scopes.example.keys : {
+      scope = key
+    }
+  ]
+}
@@ -0,0 +1,2 @@
+# Applications can be imported by organizationId:environmentId:domainId:applicationId
+terraform import graviteeioam_application.example DEFAULT:DEFAULT:7c3a2e1f-0b7d-4d2a-ba2e-1f0b7d4d2a6c:0d9a8e44-1b3f-4c8e-9a8e-441b3f4c8e5d
@@ -0,0 +1,29 @@
+resource "graviteeioam_application" "example" {
+  organization_id = graviteeioam_domain.example.organization_id
+  environment_id  = graviteeioam_domain.example.environment_id
+  domain_id       = graviteeioam_domain.example.domain_id
+  name            = "example"
+  type            = "web"
+  redirect_uris   = ["https://example.com/callback"]
+  grant_types     = ["authorization_code", "refresh_token"]
+  response_types  = ["code"]
+
+  access_token_validity_seconds  = 600
+  refresh_token_validity_seconds = 86400
+
+  scope_settings = [
+    {
+      scope         = "openid"
+      default_scope = true
+    },
+  ]
+
+  identity_providers = [
+    {
+      identity = "default-idp-${graviteeioam_domain.example.domain_id}"
+      priority = 0
+    },
+  ]
+
+  factors = [graviteeioam_factor.otp.factor_id]
+}
@@ -0,0 +1,4 @@
+# Certificates can be imported by organizationId:environmentId:domainId:certificateId,
+# the keystore and passwords are not returned by the management API and must be set again.
+# PEM keys are stored as PKCS#12 keystores and are imported with the `pkcs12` type
+terraform import graviteeioam_certificate.example DEFAULT:DEFAULT:7c3a2e1f-0b7d-4d2a-ba2e-1f0b7d4d2a6c:8a1f3c5e-7b9d-4e2f-a4c6-8e0b2d4f6a8c
@@ -0,0 +1,23 @@
+resource "graviteeioam_certificate" "example" {
+  organization_id = graviteeioam_domain.example.organization_id
+  environment_id  = graviteeioam_domain.example.environment_id
+  domain_id       = graviteeioam_domain.example.domain_id
+  name            = "Token signing 2024-Q3"
+  type            = "pkcs12"
+  keystore        = filebase64("${path.module}/signing.p12")
+  alias           = "signing"
+  store_password  = var.keystore_password
+  key_password    = var.keystore_password
+}
+
+resource "graviteeioam_certificate" "local_key" {
+  organization_id = graviteeioam_domain.example.organization_id
+  environment_id  = graviteeioam_domain.example.environment_id
+  domain_id       = graviteeioam_domain.example.domain_id
+  name            = "Token signing EC"
+  type            = "pem"
+  keystore        = base64encode("${file("${path.module}/signing-key.pem")}${file("${path.module}/signing-chain.pem")}")
+  alias           = "signing"
+  store_password  = var.keystore_password
+  key_password    = var.keystore_password
+}
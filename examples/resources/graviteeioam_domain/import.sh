@@ -0,0 +1,2 @@
+# Domains can be imported by organizationId:environmentId:domainId
+terraform import graviteeioam_domain.example DEFAULT:DEFAULT:7c3a2e1f-0b7d-4d2a-ba2e-1f0b7d4d2a6c
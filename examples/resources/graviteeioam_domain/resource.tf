@@ -0,0 +1,8 @@
+resource "graviteeioam_domain" "example" {
+  organization_id = "DEFAULT"
+  environment_id  = "DEFAULT"
+  name            = "example"
+  description     = "Example security domain"
+  enabled         = true
+  tags            = ["internal"]
+}
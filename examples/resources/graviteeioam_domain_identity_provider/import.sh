@@ -0,0 +1,2 @@
+# Domain identity providers can be imported by organizationId:environmentId:domainId:identityProviderId
+terraform import graviteeioam_domain_identity_provider.example DEFAULT:DEFAULT:7c3a2e1f-0b7d-4d2a-ba2e-1f0b7d4d2a6c:3f5e8c7a-2d4b-4e6f-9a1c-7b8d9e0f1a2b
@@ -0,0 +1,22 @@
+resource "graviteeioam_domain_identity_provider" "example" {
+  organization_id = graviteeioam_domain.example.organization_id
+  environment_id  = graviteeioam_domain.example.environment_id
+  domain_id       = graviteeioam_domain.example.domain_id
+  type            = "ldap-am-idp"
+  name            = "Corporate LDAP"
+  configuration = jsonencode({
+    contextSourceUrl      = "ldap://ldap.example.com:389"
+    contextSourceBase     = "dc=example,dc=com"
+    contextSourceUsername = "cn=admin,dc=example,dc=com"
+    contextSourcePassword = var.ldap_password
+    userSearchBase        = "ou=users"
+    userSearchFilter      = "uid={0}"
+  })
+  user_mappers = {
+    email = "mail"
+  }
+  role_mappers = {
+    "9b4d2e6a-8f1c-4a3b-b5d7-2e6a8f1c4a3b" = ["memberOf=cn=admins,ou=groups,dc=example,dc=com"]
+  }
+  domain_whitelist = ["example.com"]
+}
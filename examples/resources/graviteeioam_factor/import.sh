@@ -0,0 +1,2 @@
+# Factors can be imported by organizationId:environmentId:domainId:factorId
+terraform import graviteeioam_factor.otp DEFAULT:DEFAULT:7c3a2e1f-0b7d-4d2a-ba2e-1f0b7d4d2a6c:1c3e5a7c-9e1b-4c3e-a5a7-c9e1b3d5f7a9
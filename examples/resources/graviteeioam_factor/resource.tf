@@ -0,0 +1,27 @@
+resource "graviteeioam_factor" "otp" {
+  organization_id = graviteeioam_domain.example.organization_id
+  environment_id  = graviteeioam_domain.example.environment_id
+  domain_id       = graviteeioam_domain.example.domain_id
+  name            = "Authenticator app"
+  type            = "otp-am-factor"
+  factor_type     = "OTP"
+  configuration = jsonencode({
+    issuer       = "Example"
+    algorithm    = "HmacSHA1"
+    timeStep     = "30"
+    returnDigits = "6"
+  })
+}
+
+resource "graviteeioam_factor" "recovery_codes" {
+  organization_id = graviteeioam_domain.example.organization_id
+  environment_id  = graviteeioam_domain.example.environment_id
+  domain_id       = graviteeioam_domain.example.domain_id
+  name            = "Recovery codes"
+  type            = "recovery-code-am-factor"
+  factor_type     = "RECOVERY_CODE"
+  configuration = jsonencode({
+    digit = 5
+    count = 6
+  })
+}
@@ -0,0 +1,5 @@
+# Domain flows can be imported by organizationId:environmentId:domainId
+terraform import graviteeioam_flow.domain DEFAULT:DEFAULT:7c3a2e1f-0b7d-4d2a-ba2e-1f0b7d4d2a6c
+
+# Application flows can be imported by organizationId:environmentId:domainId:applicationId
+terraform import graviteeioam_flow.application DEFAULT:DEFAULT:7c3a2e1f-0b7d-4d2a-ba2e-1f0b7d4d2a6c:3f5b7d9f-1b3d-4f5b-9d7f-1b3d5f7b9d1f
@@ -0,0 +1,53 @@
+resource "graviteeioam_flow" "domain" {
+  organization_id = graviteeioam_domain.example.organization_id
+  environment_id  = graviteeioam_domain.example.environment_id
+  domain_id       = graviteeioam_domain.example.domain_id
+
+  flows = [
+    {
+      name = "ALL"
+      type = "ROOT"
+    },
+    {
+      name = "LOGIN"
+      type = "LOGIN"
+      pre = [
+        {
+          policy = "policy-am-enrich-profile"
+          name   = "Enrich profile"
+          configuration = jsonencode({
+            exitOnError = false
+            properties  = [{ claim = "department", claimValue = "{#context.attributes['user'].additionalInformation['department']}" }]
+          })
+        },
+      ]
+      post = [
+        {
+          policy    = "policy-http-callout"
+          name      = "Notify login"
+          condition = "{#context.attributes['user'] != null}"
+          configuration = jsonencode({
+            method      = "POST"
+            url         = "https://hooks.example.com/login"
+            exitOnError = false
+          })
+        },
+      ]
+    },
+  ]
+}
+
+resource "graviteeioam_flow" "application" {
+  organization_id = graviteeioam_domain.example.organization_id
+  environment_id  = graviteeioam_domain.example.environment_id
+  domain_id       = graviteeioam_domain.example.domain_id
+  application_id  = graviteeioam_application.example.application_id
+
+  flows = [
+    {
+      name      = "CONSENT"
+      type      = "CONSENT"
+      condition = "{#request.params['prompt'] != null}"
+    },
+  ]
+}
@@ -0,0 +1,16 @@
+resource "graviteeioam_form" "login" {
+  organization_id = graviteeioam_domain.example.organization_id
+  environment_id  = graviteeioam_domain.example.environment_id
+  domain_id       = graviteeioam_domain.example.domain_id
+  template        = "LOGIN"
+  content         = file("${path.module}/templates/login.html")
+}
+
+resource "graviteeioam_form" "registration" {
+  organization_id = graviteeioam_domain.example.organization_id
+  environment_id  = graviteeioam_domain.example.environment_id
+  domain_id       = graviteeioam_domain.example.domain_id
+  application_id  = graviteeioam_application.example.application_id
+  template        = "REGISTRATION"
+  content         = file("${path.module}/templates/registration.html")
+}
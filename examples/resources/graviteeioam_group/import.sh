@@ -0,0 +1,5 @@
+# Domain groups can be imported by organizationId:environmentId:domainId:groupId
+terraform import graviteeioam_group.support DEFAULT:DEFAULT:7c3a2e1f-0b7d-4d2a-ba2e-1f0b7d4d2a6c:8f1c3e5a-7b9d-4f2a-b4c6-1e3a5c7e9b0d
+
+# Organization groups can be imported by organizationId:groupId
+terraform import graviteeioam_group.auditors DEFAULT:4a6c8e0b-2d4f-4a1c-9e3b-5d7f9a1c3e5b
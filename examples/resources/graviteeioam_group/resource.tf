@@ -0,0 +1,15 @@
+resource "graviteeioam_group" "support" {
+  reference_type  = "domain"
+  organization_id = graviteeioam_domain.example.organization_id
+  environment_id  = graviteeioam_domain.example.environment_id
+  domain_id       = graviteeioam_domain.example.domain_id
+  name            = "Support"
+  description     = "Customer support team"
+  roles           = [graviteeioam_role.application_owner.role_id]
+}
+
+resource "graviteeioam_group" "auditors" {
+  reference_type  = "organization"
+  organization_id = "DEFAULT"
+  name            = "Auditors"
+}
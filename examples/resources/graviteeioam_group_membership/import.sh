@@ -0,0 +1,2 @@
+# Group memberships can be imported by the id of their group, every current member is then managed
+terraform import graviteeioam_group_membership.support DEFAULT:DEFAULT:7c3a2e1f-0b7d-4d2a-ba2e-1f0b7d4d2a6c:8f1c3e5a-7b9d-4f2a-b4c6-1e3a5c7e9b0d
@@ -0,0 +1,12 @@
+# Members added in the console are left untouched
+resource "graviteeioam_group_membership" "support" {
+  reference_type  = graviteeioam_group.support.reference_type
+  organization_id = graviteeioam_group.support.organization_id
+  environment_id  = graviteeioam_group.support.environment_id
+  domain_id       = graviteeioam_group.support.domain_id
+  group_id        = graviteeioam_group.support.group_id
+  members = [
+    "0d2f4a6c-8e1b-4d3f-a5c7-9e1b3d5f7a9c",
+    "6e8a0c2e-4a6c-4e8a-b0c2-4e6a8c0e2a4c",
+  ]
+}
@@ -0,0 +1,2 @@
+# Organization identity providers can be imported by organizationId:identityProviderId
+terraform import graviteeioam_organization_identity_provider.example DEFAULT:5d2e8a1c-4b7f-4c3e-9d6a-1c4b7f4c3e9d
@@ -0,0 +1,20 @@
+resource "graviteeioam_organization_identity_provider" "example" {
+  organization_id = "DEFAULT"
+  type            = "oauth2-generic-am-idp"
+  name            = "Corporate SSO"
+  configuration = jsonencode({
+    clientId              = "management-console"
+    clientSecret          = var.sso_client_secret
+    wellKnownUri          = "https://sso.example.com/.well-known/openid-configuration"
+    responseType          = "code"
+    scopes                = ["openid", "profile", "email"]
+    useIdTokenForUserInfo = true
+  })
+  user_mappers = {
+    email = "email"
+  }
+  role_mappers = {
+    "4e1a7c9d-2b5f-4d8e-a3c6-9d2b5f4d8ea3" = ["groups=platform-admins"]
+  }
+  login_page = true
+}
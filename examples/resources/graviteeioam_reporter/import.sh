@@ -0,0 +1,5 @@
+# Domain reporters can be imported by organizationId:environmentId:domainId:reporterId
+terraform import graviteeioam_reporter.file DEFAULT:DEFAULT:7c3a2e1f-0b7d-4d2a-ba2e-1f0b7d4d2a6c:2e4a6c8e-0b2d-4e4a-8c6e-0b2d4f6a8c0e
+
+# Organization reporters can be imported by organizationId:reporterId
+terraform import graviteeioam_reporter.organization DEFAULT:4c6e8a0c-2e4a-4c6e-8a0c-2e4a6c8e0a2c
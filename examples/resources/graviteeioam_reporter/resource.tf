@@ -0,0 +1,58 @@
+resource "graviteeioam_reporter" "default" {
+  reference_type  = "domain"
+  organization_id = graviteeioam_domain.example.organization_id
+  environment_id  = graviteeioam_domain.example.environment_id
+  domain_id       = graviteeioam_domain.example.domain_id
+  default         = true
+  name            = "MongoDB Reporter"
+  type            = "mongodb"
+  configuration = jsonencode({
+    uri                  = "mongodb://localhost:27017"
+    host                 = "localhost"
+    port                 = 27017
+    enableCredentials    = false
+    database             = "gravitee-am"
+    reportableCollection = "reporter_audits_${graviteeioam_domain.example.domain_id}"
+    bulkActions          = 1000
+    flushInterval        = 5
+  })
+}
+
+resource "graviteeioam_reporter" "file" {
+  reference_type  = "domain"
+  organization_id = graviteeioam_domain.example.organization_id
+  environment_id  = graviteeioam_domain.example.environment_id
+  domain_id       = graviteeioam_domain.example.domain_id
+  name            = "Audit file"
+  type            = "reporter-am-file"
+  configuration = jsonencode({
+    filename     = "audit-example"
+    outputFormat = "JSON"
+  })
+}
+
+resource "graviteeioam_reporter" "kafka" {
+  reference_type  = "domain"
+  organization_id = graviteeioam_domain.example.organization_id
+  environment_id  = graviteeioam_domain.example.environment_id
+  domain_id       = graviteeioam_domain.example.domain_id
+  name            = "Audit Kafka"
+  type            = "reporter-am-kafka"
+  enabled         = true
+  configuration = jsonencode({
+    bootstrapServers = "kafka:9092"
+    topic            = "gravitee-am-audit"
+    acks             = "1"
+  })
+}
+
+resource "graviteeioam_reporter" "organization" {
+  reference_type  = "organization"
+  organization_id = "DEFAULT"
+  name            = "Organization audit file"
+  type            = "reporter-am-file"
+  configuration = jsonencode({
+    filename     = "audit-organization"
+    outputFormat = "JSON"
+  })
+}
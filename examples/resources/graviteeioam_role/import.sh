@@ -0,0 +1,5 @@
+# Domain roles can be imported by organizationId:environmentId:domainId:roleId
+terraform import graviteeioam_role.application_owner DEFAULT:DEFAULT:7c3a2e1f-0b7d-4d2a-ba2e-1f0b7d4d2a6c:5e8a0c2d-6f1b-4b3d-8a7e-9c1d3f5a7b9e
+
+# Organization roles can be imported by organizationId:roleId
+terraform import graviteeioam_role.auditor DEFAULT:3d7f9b1a-5c2e-4e4f-a6b8-0d2f4a6c8e1b
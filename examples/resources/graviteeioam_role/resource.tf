@@ -0,0 +1,24 @@
+resource "graviteeioam_role" "application_owner" {
+  reference_type  = "domain"
+  organization_id = graviteeioam_domain.example.organization_id
+  environment_id  = graviteeioam_domain.example.environment_id
+  domain_id       = graviteeioam_domain.example.domain_id
+  name            = "Application owner"
+  description     = "Delegated administration of the applications of a team"
+  assignable_type = "APPLICATION"
+  permissions = {
+    APPLICATION        = ["READ", "UPDATE"]
+    APPLICATION_OPENID = ["READ", "UPDATE"]
+    APPLICATION_MEMBER = ["LIST", "READ"]
+  }
+}
+
+resource "graviteeioam_role" "auditor" {
+  reference_type  = "organization"
+  organization_id = "DEFAULT"
+  name            = "Auditor"
+  assignable_type = "ORGANIZATION"
+  permissions = {
+    ORGANIZATION_AUDIT = ["LIST", "READ"]
+  }
+}
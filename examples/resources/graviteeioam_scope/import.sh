@@ -0,0 +1,2 @@
+# Scopes can be imported by organizationId:environmentId:domainId:scopeId
+terraform import graviteeioam_scope.example DEFAULT:DEFAULT:7c3a2e1f-0b7d-4d2a-ba2e-1f0b7d4d2a6c:2b6d8f0a-4c1e-4a3b-9d5f-7e9a1c3b5d7f
@@ -0,0 +1,11 @@
+resource "graviteeioam_scope" "example" {
+  organization_id = graviteeioam_domain.example.organization_id
+  environment_id  = graviteeioam_domain.example.environment_id
+  domain_id       = graviteeioam_domain.example.domain_id
+  key             = "orders:read"
+  name            = "Read orders"
+  description     = "Read access to your orders"
+  expires_in      = 86400
+  discovery       = true
+  claims          = ["email"]
+}
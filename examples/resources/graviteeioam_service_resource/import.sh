@@ -0,0 +1,3 @@
+# Service resources can be imported by organizationId:environmentId:domainId:resourceId
+# The sensitive configuration is masked by AM and is set again by the next apply
+terraform import graviteeioam_service_resource.smtp DEFAULT:DEFAULT:7c3a2e1f-0b7d-4d2a-ba2e-1f0b7d4d2a6c:5b7d9f1b-3d5f-4b7d-9f1b-3d5f7b9d1f3b
@@ -0,0 +1,44 @@
+variable "smtp_password" {
+  type      = string
+  sensitive = true
+}
+
+variable "twilio_auth_token" {
+  type      = string
+  sensitive = true
+}
+
+resource "graviteeioam_service_resource" "smtp" {
+  organization_id = graviteeioam_domain.example.organization_id
+  environment_id  = graviteeioam_domain.example.environment_id
+  domain_id       = graviteeioam_domain.example.domain_id
+  name            = "SMTP"
+  type            = "smtp-am-resource"
+  configuration = jsonencode({
+    host           = "smtp.example.com"
+    port           = 587
+    from           = "no-reply@example.com"
+    protocol       = "smtp"
+    authentication = true
+    startTls       = true
+    username       = "no-reply@example.com"
+  })
+  sensitive_configuration = {
+    password = var.smtp_password
+  }
+}
+
+resource "graviteeioam_service_resource" "twilio" {
+  organization_id = graviteeioam_domain.example.organization_id
+  environment_id  = graviteeioam_domain.example.environment_id
+  domain_id       = graviteeioam_domain.example.domain_id
+  name            = "Twilio verify"
+  type            = "twilio-verify-am-resource"
+  configuration = jsonencode({
+    accountSid = "AC0123456789abcdef0123456789abcdef"
+    sid        = "VA0123456789abcdef0123456789abcdef"
+  })
+  sensitive_configuration = {
+    authToken = var.twilio_auth_token
+  }
+}
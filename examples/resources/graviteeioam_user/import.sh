@@ -0,0 +1,5 @@
+# Domain users can be imported by organizationId:environmentId:domainId:userId
+terraform import graviteeioam_user.test DEFAULT:DEFAULT:7c3a2e1f-0b7d-4d2a-ba2e-1f0b7d4d2a6c:0d2f4a6c-8e1b-4d3f-a5c7-9e1b3d5f7a9c
+
+# Organization users can be imported by organizationId:userId
+terraform import graviteeioam_user.admin DEFAULT:9b1d3f5a-7c9e-4b1d-8f3a-5c7e9b1d3f5a
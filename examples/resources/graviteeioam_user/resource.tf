@@ -0,0 +1,30 @@
+variable "test_user_password" {
+  type      = string
+  sensitive = true
+}
+
+resource "graviteeioam_user" "test" {
+  reference_type           = "domain"
+  organization_id          = graviteeioam_domain.example.organization_id
+  environment_id           = graviteeioam_domain.example.environment_id
+  domain_id                = graviteeioam_domain.example.domain_id
+  username                 = "test-user"
+  email                    = "test-user@example.com"
+  first_name               = "Test"
+  last_name                = "User"
+  password                 = var.test_user_password
+  reset_password_on_change = true
+  additional_information = {
+    team = "qa"
+  }
+}
+
+# Users created without a password are sent a link to complete their registration
+resource "graviteeioam_user" "admin" {
+  reference_type  = "organization"
+  organization_id = "DEFAULT"
+  username        = "jane.doe"
+  email           = "jane.doe@example.com"
+  first_name      = "Jane"
+  last_name       = "Doe"
+}
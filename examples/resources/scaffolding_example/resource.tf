@@ -1,3 +0,0 @@
-resource "graviteeioam_domain" "example" {
-  configurable_attribute = "some-value"
-}
@@ -0,0 +1,458 @@
+package application
+
+import (
+	"context"
+	"math"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/thornleyk/graviteeioam-service/client"
+	"github.com/thornleyk/terraform-provider-graviteeioam/internal/model/convert"
+)
+
+// applicationTypes maps the application types of the resource to the
+// management API types.
+var applicationTypes = map[string]string{
+	"web":             "WEB",
+	"spa":             "BROWSER",
+	"native":          "NATIVE",
+	"service":         "SERVICE",
+	"resource_server": "RESOURCE_SERVER",
+}
+
+var scopeSettingsType = types.ObjectType{AttrTypes: map[string]attr.Type{
+	"scope":          types.StringType,
+	"default_scope":  types.BoolType,
+	"scope_approval": types.Int64Type,
+}}
+
+var identityProviderType = types.ObjectType{AttrTypes: map[string]attr.Type{
+	"identity":       types.StringType,
+	"priority":       types.Int64Type,
+	"selection_rule": types.StringType,
+}}
+
+type ApplicationScopeSettings struct {
+	Scope         types.String `tfsdk:"scope"`
+	DefaultScope  types.Bool   `tfsdk:"default_scope"`
+	ScopeApproval types.Int64  `tfsdk:"scope_approval"`
+}
+
+type ApplicationIdentityProvider struct {
+	Identity      types.String `tfsdk:"identity"`
+	Priority      types.Int64  `tfsdk:"priority"`
+	SelectionRule types.String `tfsdk:"selection_rule"`
+}
+
+type ApplicationResourceModel struct {
+	Id                          types.String `tfsdk:"id"`
+	OrganizationId              types.String `tfsdk:"organization_id"`
+	EnvironmentId               types.String `tfsdk:"environment_id"`
+	DomainId                    types.String `tfsdk:"domain_id"`
+	ApplicationId               types.String `tfsdk:"application_id"`
+	Name                        types.String `tfsdk:"name"`
+	Description                 types.String `tfsdk:"description"`
+	Type                        types.String `tfsdk:"type"`
+	Enabled                     types.Bool   `tfsdk:"enabled"`
+	ClientId                    types.String `tfsdk:"client_id"`
+	ClientSecret                types.String `tfsdk:"client_secret"`
+	RedirectURIs                types.List   `tfsdk:"redirect_uris"`
+	GrantTypes                  types.Set    `tfsdk:"grant_types"`
+	ResponseTypes               types.Set    `tfsdk:"response_types"`
+	AccessTokenValiditySeconds  types.Int64  `tfsdk:"access_token_validity_seconds"`
+	RefreshTokenValiditySeconds types.Int64  `tfsdk:"refresh_token_validity_seconds"`
+	IdTokenValiditySeconds      types.Int64  `tfsdk:"id_token_validity_seconds"`
+	ScopeSettings               types.List   `tfsdk:"scope_settings"`
+	IdentityProviders           types.Set    `tfsdk:"identity_providers"`
+	Factors                     types.Set    `tfsdk:"factors"`
+}
+
+// APIApplicationType returns the management API type of a resource type.
+func APIApplicationType(source types.String) string {
+	if apiType, ok := applicationTypes[source.ValueString()]; ok {
+		return apiType
+	}
+	return strings.ToUpper(source.ValueString())
+}
+
+func mapApplicationType(source *client.ApplicationType) types.String {
+	if source == nil {
+		return types.StringNull()
+	}
+	for resourceType, apiType := range applicationTypes {
+		if apiType == string(*source) {
+			return types.StringValue(resourceType)
+		}
+	}
+	return types.StringValue(strings.ToLower(string(*source)))
+}
+
+func NewApplicationFromResource(ctx context.Context, source ApplicationResourceModel) (client.NewApplication, diag.Diagnostics) {
+	redirectURIs, diags := convert.OptionalStrings(ctx, source.RedirectURIs)
+	return client.NewApplication{
+		Name:         source.Name.ValueString(),
+		Type:         client.NewApplicationType(APIApplicationType(source.Type)),
+		Description:  convert.OptionalString(source.Description),
+		RedirectUris: redirectURIs,
+	}, diags
+}
+
+func PatchApplicationFromResource(ctx context.Context, source ApplicationResourceModel) (client.PatchApplication, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	oauth := &client.PatchApplicationOAuthSettings{
+		AccessTokenValiditySeconds:  convert.OptionalInt32(source.AccessTokenValiditySeconds),
+		RefreshTokenValiditySeconds: convert.OptionalInt32(source.RefreshTokenValiditySeconds),
+		IdTokenValiditySeconds:      convert.OptionalInt32(source.IdTokenValiditySeconds),
+	}
+
+	// Redirect URIs are not computed, removing them from the configuration
+	// clears them.
+	redirectURIs, listDiags := convert.OptionalStrings(ctx, source.RedirectURIs)
+	diags.Append(listDiags...)
+	if redirectURIs == nil {
+		redirectURIs = &[]string{}
+	}
+	oauth.RedirectUris = redirectURIs
+
+	oauth.GrantTypes, listDiags = convert.OptionalStrings(ctx, source.GrantTypes)
+	diags.Append(listDiags...)
+	oauth.ResponseTypes, listDiags = convert.OptionalStrings(ctx, source.ResponseTypes)
+	diags.Append(listDiags...)
+
+	// Scope settings and identity providers are not computed either, unset
+	// ones are cleared and unknown ones are left to AM.
+	if !source.ScopeSettings.IsUnknown() {
+		var settingsList []ApplicationScopeSettings
+		if !source.ScopeSettings.IsNull() {
+			diags.Append(source.ScopeSettings.ElementsAs(ctx, &settingsList, false)...)
+		}
+		scopeSettings := make([]client.ApplicationScopeSettings, 0, len(settingsList))
+		for _, settings := range settingsList {
+			scopeSettings = append(scopeSettings, client.ApplicationScopeSettings{
+				Scope:         convert.OptionalString(settings.Scope),
+				DefaultScope:  convert.OptionalBool(settings.DefaultScope),
+				ScopeApproval: convert.OptionalInt32(settings.ScopeApproval),
+			})
+		}
+		oauth.ScopeSettings = &scopeSettings
+	}
+
+	// An empty description clears it, the patch leaves nil fields untouched.
+	description := source.Description.ValueString()
+	target := client.PatchApplication{
+		Name:        source.Name.ValueStringPointer(),
+		Description: &description,
+		Enabled:     convert.OptionalBool(source.Enabled),
+		Settings:    &client.PatchApplicationSettings{Oauth: oauth},
+	}
+	if !source.IdentityProviders.IsUnknown() {
+		var identityProviderList []ApplicationIdentityProvider
+		if !source.IdentityProviders.IsNull() {
+			diags.Append(source.IdentityProviders.ElementsAs(ctx, &identityProviderList, false)...)
+		}
+		identityProviders := make([]client.PatchApplicationIdentityProvider, 0, len(identityProviderList))
+		for _, identityProvider := range identityProviderList {
+			identityProviders = append(identityProviders, client.PatchApplicationIdentityProvider{
+				Identity:      convert.OptionalString(identityProvider.Identity),
+				Priority:      convert.OptionalInt32(identityProvider.Priority),
+				SelectionRule: convert.OptionalString(identityProvider.SelectionRule),
+			})
+		}
+		target.IdentityProviders = &identityProviders
+	}
+	// Factors are not computed, removing them from the configuration
+	// detaches them.
+	factors, setDiags := convert.OptionalStrings(ctx, source.Factors)
+	diags.Append(setDiags...)
+	if factors == nil && !source.Factors.IsUnknown() {
+		factors = &[]string{}
+	}
+	target.Factors = factors
+	return target, diags
+}
+
+func MapApplicationResource(ctx context.Context, source *client.Application, target ApplicationResourceModel) (ApplicationResourceModel, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	target.ApplicationId = convert.String(source.Id)
+	target.Id = types.StringValue(target.OrganizationId.ValueString() + ":" + target.EnvironmentId.ValueString() + ":" + target.DomainId.ValueString() + ":" + target.ApplicationId.ValueString())
+	target.Name = convert.String(source.Name)
+	if (source.Description != nil && *source.Description != "") || !target.Description.IsNull() {
+		target.Description = convert.String(source.Description)
+	}
+	target.Type = mapApplicationType(source.Type)
+	target.Enabled = types.BoolValue(source.Enabled != nil && *source.Enabled)
+
+	var oauth client.ApplicationOAuthSettings
+	if source.Settings != nil && source.Settings.Oauth != nil {
+		oauth = *source.Settings.Oauth
+	}
+	target.ClientId = convert.String(oauth.ClientId)
+	// Recent AM versions only return the client secret when it is generated,
+	// keep the known secret otherwise.
+	if oauth.ClientSecret != nil {
+		target.ClientSecret = convert.String(oauth.ClientSecret)
+	} else if target.ClientSecret.IsUnknown() {
+		target.ClientSecret = types.StringNull()
+	}
+	if (oauth.RedirectUris != nil && len(*oauth.RedirectUris) > 0) || !target.RedirectURIs.IsNull() {
+		target.RedirectURIs = convert.StringList(oauth.RedirectUris)
+		if target.RedirectURIs.IsNull() {
+			target.RedirectURIs = types.ListValueMust(types.StringType, nil)
+		}
+	}
+	target.GrantTypes = convert.StringSet(oauth.GrantTypes)
+	target.ResponseTypes = convert.StringSet(oauth.ResponseTypes)
+	target.AccessTokenValiditySeconds = convert.Int32(oauth.AccessTokenValiditySeconds)
+	target.RefreshTokenValiditySeconds = convert.Int32(oauth.RefreshTokenValiditySeconds)
+	target.IdTokenValiditySeconds = convert.Int32(oauth.IdTokenValiditySeconds)
+
+	scopeSettings := []ApplicationScopeSettings{}
+	if oauth.ScopeSettings != nil {
+		for _, settings := range *oauth.ScopeSettings {
+			scopeSettings = append(scopeSettings, ApplicationScopeSettings{
+				Scope:         convert.String(settings.Scope),
+				DefaultScope:  types.BoolValue(settings.DefaultScope != nil && *settings.DefaultScope),
+				ScopeApproval: convert.Int32(settings.ScopeApproval),
+			})
+		}
+	}
+
+	// Empty nested settings are kept null unless configured.
+	var valueDiags diag.Diagnostics
+	if len(scopeSettings) > 0 || !target.ScopeSettings.IsNull() {
+		target.ScopeSettings, valueDiags = types.ListValueFrom(ctx, scopeSettingsType, scopeSettings)
+		diags.Append(valueDiags...)
+	}
+
+	identityProviders := []ApplicationIdentityProvider{}
+	if source.IdentityProviders != nil {
+		for _, identityProvider := range *source.IdentityProviders {
+			selectionRule := convert.String(identityProvider.SelectionRule)
+			if selectionRule.ValueString() == "" {
+				selectionRule = types.StringNull()
+			}
+			identityProviders = append(identityProviders, ApplicationIdentityProvider{
+				Identity:      convert.String(identityProvider.Identity),
+				Priority:      convert.Int32(identityProvider.Priority),
+				SelectionRule: selectionRule,
+			})
+		}
+	}
+
+	if len(identityProviders) > 0 || !target.IdentityProviders.IsNull() {
+		target.IdentityProviders, valueDiags = types.SetValueFrom(ctx, identityProviderType, identityProviders)
+		diags.Append(valueDiags...)
+	}
+
+	if (source.Factors != nil && len(*source.Factors) > 0) || !target.Factors.IsNull() {
+		factors := []string{}
+		if source.Factors != nil {
+			factors = *source.Factors
+		}
+		target.Factors = convert.StringSet(&factors)
+	}
+	return target, diags
+}
+
+func GetApplicationResourceSchema() *schema.Schema {
+	return &schema.Schema{
+		MarkdownDescription: "Application resource, an OAuth 2.0 / OpenID Connect client of a security domain",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "TF identifier in the form organizationId:environmentId:domainId:applicationId",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"organization_id": schema.StringAttribute{
+				MarkdownDescription: "Organization id",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"environment_id": schema.StringAttribute{
+				MarkdownDescription: "Environment id",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"domain_id": schema.StringAttribute{
+				MarkdownDescription: "Domain id",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"application_id": schema.StringAttribute{
+				MarkdownDescription: "Application id",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Application name",
+				Required:            true,
+			},
+			"description": schema.StringAttribute{
+				MarkdownDescription: "Application description",
+				Optional:            true,
+			},
+			"type": schema.StringAttribute{
+				MarkdownDescription: "Application type, one of `web`, `spa`, `native`, `service` or `resource_server`",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("web", "spa", "native", "service", "resource_server"),
+				},
+			},
+			"enabled": schema.BoolAttribute{
+				MarkdownDescription: "Application enabled",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(true),
+			},
+			"client_id": schema.StringAttribute{
+				MarkdownDescription: "OAuth client id",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"client_secret": schema.StringAttribute{
+				MarkdownDescription: "OAuth client secret",
+				Computed:            true,
+				Sensitive:           true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"redirect_uris": schema.ListAttribute{
+				MarkdownDescription: "Allowed redirect URIs",
+				ElementType:         types.StringType,
+				Optional:            true,
+			},
+			"grant_types": schema.SetAttribute{
+				MarkdownDescription: "OAuth grant types, defaulted by AM from the application type when not set",
+				ElementType:         types.StringType,
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.Set{
+					setplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"response_types": schema.SetAttribute{
+				MarkdownDescription: "OAuth response types, defaulted by AM from the application type when not set",
+				ElementType:         types.StringType,
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.Set{
+					setplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"access_token_validity_seconds": schema.Int64Attribute{
+				MarkdownDescription: "Access token time to live, in seconds",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+				Validators: []validator.Int64{
+					int64validator.Between(0, math.MaxInt32),
+				},
+			},
+			"refresh_token_validity_seconds": schema.Int64Attribute{
+				MarkdownDescription: "Refresh token time to live, in seconds",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+				Validators: []validator.Int64{
+					int64validator.Between(0, math.MaxInt32),
+				},
+			},
+			"id_token_validity_seconds": schema.Int64Attribute{
+				MarkdownDescription: "ID token time to live, in seconds",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+				Validators: []validator.Int64{
+					int64validator.Between(0, math.MaxInt32),
+				},
+			},
+			"scope_settings": schema.ListNestedAttribute{
+				MarkdownDescription: "Scopes the application may request",
+				Optional:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"scope": schema.StringAttribute{
+							MarkdownDescription: "Scope key",
+							Required:            true,
+						},
+						"default_scope": schema.BoolAttribute{
+							MarkdownDescription: "Granted when the client does not request any scope",
+							Optional:            true,
+							Computed:            true,
+							Default:             booldefault.StaticBool(false),
+						},
+						"scope_approval": schema.Int64Attribute{
+							MarkdownDescription: "User consent lifetime for the scope, in seconds, defaulted by AM when not set",
+							Optional:            true,
+							Computed:            true,
+							Validators: []validator.Int64{
+								int64validator.Between(0, math.MaxInt32),
+							},
+						},
+					},
+				},
+			},
+			"identity_providers": schema.SetNestedAttribute{
+				MarkdownDescription: "Identity providers users of the application log in with",
+				Optional:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"identity": schema.StringAttribute{
+							MarkdownDescription: "Identity provider id",
+							Required:            true,
+						},
+						"priority": schema.Int64Attribute{
+							MarkdownDescription: "Order of the identity provider on the login page, defaulted by AM when not set",
+							Optional:            true,
+							Computed:            true,
+							Validators: []validator.Int64{
+								int64validator.Between(0, math.MaxInt32),
+							},
+						},
+						"selection_rule": schema.StringAttribute{
+							MarkdownDescription: "Expression language rule selecting the identity provider",
+							Optional:            true,
+						},
+					},
+				},
+			},
+			"factors": schema.SetAttribute{
+				MarkdownDescription: "Ids of the factors users of the application can enroll for multi-factor authentication",
+				ElementType:         types.StringType,
+				Optional:            true,
+			},
+		},
+	}
+}
@@ -0,0 +1,230 @@
+package application
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/thornleyk/graviteeioam-service/client"
+)
+
+func testApplicationResourceModel() ApplicationResourceModel {
+	return ApplicationResourceModel{
+		OrganizationId: types.StringValue("DEFAULT"),
+		EnvironmentId:  types.StringValue("DEFAULT"),
+		DomainId:       types.StringValue("domain"),
+		Name:           types.StringValue("app"),
+		Description:    types.StringNull(),
+		Type:           types.StringValue("spa"),
+		Enabled:        types.BoolValue(true),
+		ClientSecret:   types.StringUnknown(),
+		RedirectURIs:   types.ListNull(types.StringType),
+		GrantTypes:     types.SetUnknown(types.StringType),
+		ResponseTypes:  types.SetValueMust(types.StringType, []attr.Value{types.StringValue("code")}),
+
+		AccessTokenValiditySeconds:  types.Int64Value(600),
+		RefreshTokenValiditySeconds: types.Int64Unknown(),
+		IdTokenValiditySeconds:      types.Int64Unknown(),
+		ScopeSettings:               types.ListUnknown(scopeSettingsType),
+		IdentityProviders:           types.SetUnknown(identityProviderType),
+		Factors:                     types.SetUnknown(types.StringType),
+	}
+}
+
+func TestPatchApplicationFromResource(t *testing.T) {
+	patch, diags := PatchApplicationFromResource(context.Background(), testApplicationResourceModel())
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+
+	oauth := patch.Settings.Oauth
+	if oauth.RedirectUris == nil || len(*oauth.RedirectUris) != 0 {
+		t.Errorf("expected unset redirect URIs to be cleared, got %v", oauth.RedirectUris)
+	}
+	if oauth.GrantTypes != nil || oauth.RefreshTokenValiditySeconds != nil {
+		t.Errorf("expected unknown computed settings to be left to AM, got %+v", oauth)
+	}
+	if oauth.ResponseTypes == nil || (*oauth.ResponseTypes)[0] != "code" || *oauth.AccessTokenValiditySeconds != 600 {
+		t.Errorf("expected configured settings to be sent, got %+v", oauth)
+	}
+	if patch.IdentityProviders != nil || oauth.ScopeSettings != nil || patch.Factors != nil {
+		t.Errorf("expected unset nested settings to be left to AM, got %+v", patch)
+	}
+
+	data := testApplicationResourceModel()
+	data.Factors = types.SetValueMust(types.StringType, []attr.Value{types.StringValue("otp")})
+	patch, _ = PatchApplicationFromResource(context.Background(), data)
+	if patch.Factors == nil || len(*patch.Factors) != 1 || (*patch.Factors)[0] != "otp" {
+		t.Errorf("expected configured factors to be sent, got %v", patch.Factors)
+	}
+	if patch.Description == nil || *patch.Description != "" {
+		t.Errorf("expected an unset description to be cleared, got %v", patch.Description)
+	}
+
+	data = testApplicationResourceModel()
+	data.ScopeSettings = types.ListValueMust(scopeSettingsType, []attr.Value{types.ObjectValueMust(scopeSettingsType.AttrTypes, map[string]attr.Value{
+		"scope":          types.StringValue("openid"),
+		"default_scope":  types.BoolValue(true),
+		"scope_approval": types.Int64Null(),
+	})})
+	data.IdentityProviders = types.SetValueMust(identityProviderType, []attr.Value{types.ObjectValueMust(identityProviderType.AttrTypes, map[string]attr.Value{
+		"identity":       types.StringValue("default-idp"),
+		"priority":       types.Int64Value(0),
+		"selection_rule": types.StringNull(),
+	})})
+	patch, diags = PatchApplicationFromResource(context.Background(), data)
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+	if patch.Settings.Oauth.ScopeSettings == nil || *(*patch.Settings.Oauth.ScopeSettings)[0].Scope != "openid" || !*(*patch.Settings.Oauth.ScopeSettings)[0].DefaultScope {
+		t.Errorf("expected configured scope settings to be sent, got %v", patch.Settings.Oauth.ScopeSettings)
+	}
+	if patch.IdentityProviders == nil || *(*patch.IdentityProviders)[0].Identity != "default-idp" {
+		t.Errorf("expected configured identity providers to be sent, got %v", patch.IdentityProviders)
+	}
+}
+
+func TestPatchApplicationFromResourceClearsUnsetNestedSettings(t *testing.T) {
+	data := testApplicationResourceModel()
+	data.ScopeSettings = types.ListNull(scopeSettingsType)
+	data.IdentityProviders = types.SetNull(identityProviderType)
+
+	patch, diags := PatchApplicationFromResource(context.Background(), data)
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+	if patch.Settings.Oauth.ScopeSettings == nil || len(*patch.Settings.Oauth.ScopeSettings) != 0 {
+		t.Errorf("expected unset scope settings to be cleared, got %v", patch.Settings.Oauth.ScopeSettings)
+	}
+	if patch.IdentityProviders == nil || len(*patch.IdentityProviders) != 0 {
+		t.Errorf("expected unset identity providers to be cleared, got %v", patch.IdentityProviders)
+	}
+
+	target, diags := MapApplicationResource(context.Background(), &client.Application{}, data)
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+	if !target.ScopeSettings.IsNull() || !target.IdentityProviders.IsNull() {
+		t.Errorf("expected cleared nested settings to stay null, got %+v", target)
+	}
+}
+
+func TestApplicationResourceModelDecodesUnknownNestedSettings(t *testing.T) {
+	ctx := context.Background()
+	resourceSchema := *GetApplicationResourceSchema()
+	plan := tfsdk.Plan{Schema: resourceSchema, Raw: tftypes.NewValue(resourceSchema.Type().TerraformType(ctx), nil)}
+	data := testApplicationResourceModel()
+	data.Id = types.StringUnknown()
+	data.ApplicationId = types.StringUnknown()
+	data.ClientId = types.StringUnknown()
+	if diags := plan.Set(ctx, &data); diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+
+	var decoded ApplicationResourceModel
+	if diags := plan.Get(ctx, &decoded); diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+	if !decoded.ScopeSettings.IsUnknown() || !decoded.IdentityProviders.IsUnknown() {
+		t.Errorf("expected unknown nested settings, got %+v", decoded)
+	}
+
+	patch, diags := PatchApplicationFromResource(ctx, decoded)
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+	if patch.Settings.Oauth.ScopeSettings != nil || patch.IdentityProviders != nil {
+		t.Errorf("expected unknown nested settings to be left to AM, got %+v", patch)
+	}
+}
+
+func TestMapApplicationResource(t *testing.T) {
+	var source client.Application
+	payload := `{
+		"id": "app-id",
+		"name": "app",
+		"description": "",
+		"type": "BROWSER",
+		"enabled": true,
+		"identityProviders": [{"identity": "default-idp", "priority": 0, "selectionRule": ""}],
+		"factors": ["otp"],
+		"settings": {"oauth": {
+			"clientId": "client",
+			"grantTypes": ["authorization_code"],
+			"responseTypes": ["code"],
+			"accessTokenValiditySeconds": 600,
+			"scopeSettings": [{"scope": "openid", "defaultScope": true}]
+		}}
+	}`
+	if err := json.Unmarshal([]byte(payload), &source); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	target, diags := MapApplicationResource(context.Background(), &source, testApplicationResourceModel())
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+	if target.Id.ValueString() != "DEFAULT:DEFAULT:domain:app-id" || target.Type.ValueString() != "spa" || target.ClientId.ValueString() != "client" {
+		t.Errorf("unexpected application: %+v", target)
+	}
+	if !target.Description.IsNull() || !target.RedirectURIs.IsNull() || !target.ClientSecret.IsNull() {
+		t.Errorf("expected unset values to stay null, got %+v", target)
+	}
+	if !target.RefreshTokenValiditySeconds.IsNull() || target.AccessTokenValiditySeconds.ValueInt64() != 600 {
+		t.Errorf("unexpected token validity: %+v", target)
+	}
+	var scopeSettings []ApplicationScopeSettings
+	target.ScopeSettings.ElementsAs(context.Background(), &scopeSettings, false)
+	if len(scopeSettings) != 1 || !scopeSettings[0].DefaultScope.ValueBool() || !scopeSettings[0].ScopeApproval.IsNull() {
+		t.Errorf("unexpected scope settings: %+v", target.ScopeSettings)
+	}
+	var identityProviders []ApplicationIdentityProvider
+	target.IdentityProviders.ElementsAs(context.Background(), &identityProviders, false)
+	if len(identityProviders) != 1 || identityProviders[0].Priority.ValueInt64() != 0 || !identityProviders[0].SelectionRule.IsNull() {
+		t.Errorf("unexpected identity providers: %+v", target.IdentityProviders)
+	}
+	if !target.Factors.Equal(types.SetValueMust(types.StringType, []attr.Value{types.StringValue("otp")})) {
+		t.Errorf("unexpected factors: %v", target.Factors)
+	}
+}
+
+func TestMapApplicationResourceKeepsClientSecret(t *testing.T) {
+	data := testApplicationResourceModel()
+	data.ClientSecret = types.StringValue("secret")
+
+	target, diags := MapApplicationResource(context.Background(), &client.Application{}, data)
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+	if target.ClientSecret.ValueString() != "secret" {
+		t.Errorf("expected the known client secret to be kept, got %s", target.ClientSecret)
+	}
+	if target.ScopeSettings.IsNull() || target.IdentityProviders.IsNull() || target.Factors.IsNull() {
+		t.Errorf("expected empty nested settings on a sparse payload, got %+v", target)
+	}
+}
+
+func TestPatchApplicationFromResourceDetachesUnsetFactors(t *testing.T) {
+	data := testApplicationResourceModel()
+	data.Factors = types.SetNull(types.StringType)
+
+	patch, diags := PatchApplicationFromResource(context.Background(), data)
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+	if patch.Factors == nil || len(*patch.Factors) != 0 {
+		t.Errorf("expected unset factors to be detached, got %v", patch.Factors)
+	}
+
+	target, diags := MapApplicationResource(context.Background(), &client.Application{}, data)
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+	if !target.Factors.IsNull() {
+		t.Errorf("expected detached factors to stay null, got %s", target.Factors)
+	}
+}
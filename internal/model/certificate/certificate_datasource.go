@@ -0,0 +1,102 @@
+package certificate
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/thornleyk/graviteeioam-service/client"
+	"github.com/thornleyk/terraform-provider-graviteeioam/internal/model/convert"
+)
+
+type CertificateLightDataSourceModel struct {
+	CertificateId types.String `tfsdk:"certificate_id"`
+	Name          types.String `tfsdk:"name"`
+	Type          types.String `tfsdk:"type"`
+	Status        types.String `tfsdk:"status"`
+	System        types.Bool   `tfsdk:"system"`
+	ExpiresAt     types.String `tfsdk:"expires_at"`
+}
+
+type CertificatesDataSourceModel struct {
+	Id             types.String                      `tfsdk:"id"`
+	OrganizationId types.String                      `tfsdk:"organization_id"`
+	EnvironmentId  types.String                      `tfsdk:"environment_id"`
+	DomainId       types.String                      `tfsdk:"domain_id"`
+	Certificates   []CertificateLightDataSourceModel `tfsdk:"certificates"`
+}
+
+func MapCertificatesDataSource(source []client.CertificateEntity, target CertificatesDataSourceModel) (CertificatesDataSourceModel, error) {
+	target.Id = types.StringValue(target.OrganizationId.ValueString() + ":" + target.EnvironmentId.ValueString() + ":" + target.DomainId.ValueString())
+	target.Certificates = []CertificateLightDataSourceModel{}
+	for _, certificate := range source {
+		certificateData := CertificateLightDataSourceModel{
+			CertificateId: convert.String(certificate.Id),
+			Name:          convert.String(certificate.Name),
+			Type:          mapCertificateType(certificate.Type),
+			Status:        types.StringNull(),
+			System:        convert.Bool(certificate.System),
+			ExpiresAt:     convert.Timestamp(certificate.ExpiresAt),
+		}
+		if certificate.Status != nil {
+			certificateData.Status = types.StringValue(string(*certificate.Status))
+		}
+		target.Certificates = append(target.Certificates, certificateData)
+	}
+	return target, nil
+}
+
+func GetCertificatesDataSourceSchema() *schema.Schema {
+	return &schema.Schema{
+		MarkdownDescription: "Certificates data source, the certificates of a security domain",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "TF identifier in the form organizationId:environmentId:domainId",
+				Computed:            true,
+			},
+			"organization_id": schema.StringAttribute{
+				MarkdownDescription: "Organization id",
+				Required:            true,
+			},
+			"environment_id": schema.StringAttribute{
+				MarkdownDescription: "Environment id",
+				Required:            true,
+			},
+			"domain_id": schema.StringAttribute{
+				MarkdownDescription: "Domain id",
+				Required:            true,
+			},
+			"certificates": schema.ListNestedAttribute{
+				MarkdownDescription: "Domain certificates",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"certificate_id": schema.StringAttribute{
+							MarkdownDescription: "Certificate id",
+							Computed:            true,
+						},
+						"name": schema.StringAttribute{
+							MarkdownDescription: "Certificate name",
+							Computed:            true,
+						},
+						"type": schema.StringAttribute{
+							MarkdownDescription: "Certificate keystore type, `jks`, `pkcs12` or the certificate plugin id",
+							Computed:            true,
+						},
+						"status": schema.StringAttribute{
+							MarkdownDescription: "Certificate status, one of `VALID`, `WILL_EXPIRE`, `EXPIRED` or `RENEWED`",
+							Computed:            true,
+						},
+						"system": schema.BoolAttribute{
+							MarkdownDescription: "Certificate generated by the platform",
+							Computed:            true,
+						},
+						"expires_at": schema.StringAttribute{
+							MarkdownDescription: "Certificate expiry date, in RFC 3339 format",
+							Computed:            true,
+						},
+					},
+				},
+				Computed: true,
+			},
+		},
+	}
+}
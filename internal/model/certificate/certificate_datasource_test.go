@@ -0,0 +1,38 @@
+package certificate
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/thornleyk/graviteeioam-service/client"
+)
+
+func TestMapCertificatesDataSource(t *testing.T) {
+	var source []client.CertificateEntity
+	payload := `[
+		{"id": "default", "name": "Default", "type": "javakeystore-am-certificate", "system": true, "status": "VALID", "expiresAt": 1700000000000},
+		{"id": "hsm", "name": "HSM", "type": "aws-hsm-am-certificate"}
+	]`
+	if err := json.Unmarshal([]byte(payload), &source); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	target, err := MapCertificatesDataSource(source, CertificatesDataSourceModel{
+		OrganizationId: types.StringValue("DEFAULT"),
+		EnvironmentId:  types.StringValue("DEFAULT"),
+		DomainId:       types.StringValue("domain"),
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if target.Id.ValueString() != "DEFAULT:DEFAULT:domain" || len(target.Certificates) != 2 {
+		t.Fatalf("unexpected certificates: %+v", target)
+	}
+	if certificate := target.Certificates[0]; certificate.Type.ValueString() != "jks" || !certificate.System.ValueBool() || certificate.ExpiresAt.ValueString() != "2023-11-14T22:13:20Z" {
+		t.Errorf("unexpected certificate: %+v", certificate)
+	}
+	if certificate := target.Certificates[1]; certificate.Type.ValueString() != "aws-hsm-am-certificate" || !certificate.Status.IsNull() || !certificate.ExpiresAt.IsNull() {
+		t.Errorf("unexpected certificate: %+v", certificate)
+	}
+}
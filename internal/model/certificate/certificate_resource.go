@@ -0,0 +1,272 @@
+package certificate
+
+import (
+	"encoding/base64"
+	"encoding/json"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/thornleyk/graviteeioam-service/client"
+	"github.com/thornleyk/terraform-provider-graviteeioam/internal/model/convert"
+)
+
+type certificatePlugin struct {
+	// Id is the certificate plugin id.
+	Id string
+	// KeystoreField is the configuration field holding the keystore file.
+	KeystoreField string
+	// FileName is the name the keystore file is uploaded as.
+	FileName string
+}
+
+// certificateTypes maps the certificate types of the resource to the
+// certificate plugins.
+var certificateTypes = map[string]certificatePlugin{
+	"jks":    {Id: "javakeystore-am-certificate", KeystoreField: "jks", FileName: "keystore.jks"},
+	"pkcs12": {Id: "pkcs12-am-certificate", KeystoreField: "content", FileName: "keystore.p12"},
+}
+
+// pemType is the certificate type of PEM encoded RSA and EC keys, they are
+// bundled with their certificate chain into a PKCS#12 keystore.
+const pemType = "pem"
+
+func certificatePluginOf(certificateType string) certificatePlugin {
+	if certificateType == pemType {
+		return certificateTypes["pkcs12"]
+	}
+	return certificateTypes[certificateType]
+}
+
+// certificateFile is the uploaded file descriptor expected by the certificate
+// plugins, serialized as a JSON string within the configuration.
+type certificateFile struct {
+	Name    string `json:"name"`
+	Type    string `json:"type"`
+	Size    int    `json:"size"`
+	Content string `json:"content"`
+}
+
+type CertificateResourceModel struct {
+	Id             types.String `tfsdk:"id"`
+	OrganizationId types.String `tfsdk:"organization_id"`
+	EnvironmentId  types.String `tfsdk:"environment_id"`
+	DomainId       types.String `tfsdk:"domain_id"`
+	CertificateId  types.String `tfsdk:"certificate_id"`
+	Name           types.String `tfsdk:"name"`
+	Type           types.String `tfsdk:"type"`
+	Keystore       types.String `tfsdk:"keystore"`
+	Alias          types.String `tfsdk:"alias"`
+	StorePassword  types.String `tfsdk:"store_password"`
+	KeyPassword    types.String `tfsdk:"key_password"`
+	Status         types.String `tfsdk:"status"`
+	ExpiresAt      types.String `tfsdk:"expires_at"`
+	PublicKey      types.String `tfsdk:"public_key"`
+}
+
+// CertificateConfiguration returns the plugin configuration of a certificate,
+// the keystore is uploaded as a file descriptor.
+func CertificateConfiguration(source CertificateResourceModel) (string, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	plugin := certificatePluginOf(source.Type.ValueString())
+
+	content := source.Keystore.ValueString()
+	keystore, err := base64.StdEncoding.DecodeString(content)
+	if err != nil {
+		diags.AddAttributeError(path.Root("keystore"), "Invalid keystore", "The keystore must be base64 encoded: "+err.Error())
+		return "", diags
+	}
+	if source.Type.ValueString() == pemType {
+		keystore, err = pemToPKCS12(keystore, source.Alias.ValueString(), source.StorePassword.ValueString(), source.KeyPassword.ValueString())
+		if err != nil {
+			diags.AddAttributeError(path.Root("keystore"), "Invalid PEM key", "The keystore must hold a PEM encoded RSA or EC private key followed by its certificate chain: "+err.Error())
+			return "", diags
+		}
+		content = base64.StdEncoding.EncodeToString(keystore)
+	}
+	file, err := json.Marshal(certificateFile{
+		Name:    plugin.FileName,
+		Size:    len(keystore),
+		Content: content,
+	})
+	if err != nil {
+		diags.AddError("Unable to encode the keystore", err.Error())
+		return "", diags
+	}
+	configuration, err := json.Marshal(map[string]string{
+		plugin.KeystoreField: string(file),
+		"storepass":          source.StorePassword.ValueString(),
+		"alias":              source.Alias.ValueString(),
+		"keypass":            source.KeyPassword.ValueString(),
+	})
+	if err != nil {
+		diags.AddError("Unable to encode the configuration", err.Error())
+		return "", diags
+	}
+	return string(configuration), diags
+}
+
+func NewCertificateFromResource(source CertificateResourceModel) (client.NewCertificate, diag.Diagnostics) {
+	configuration, diags := CertificateConfiguration(source)
+	return client.NewCertificate{
+		Type:          certificatePluginOf(source.Type.ValueString()).Id,
+		Name:          source.Name.ValueString(),
+		Configuration: configuration,
+	}, diags
+}
+
+func UpdateCertificateFromResource(source CertificateResourceModel) (client.UpdateCertificate, diag.Diagnostics) {
+	configuration, diags := CertificateConfiguration(source)
+	return client.UpdateCertificate{
+		Name:          source.Name.ValueString(),
+		Configuration: configuration,
+	}, diags
+}
+
+func mapCertificateType(source *string) types.String {
+	if source == nil {
+		return types.StringNull()
+	}
+	for resourceType, plugin := range certificateTypes {
+		if plugin.Id == *source {
+			return types.StringValue(resourceType)
+		}
+	}
+	return types.StringValue(*source)
+}
+
+// PublicKey returns the PEM public key of a certificate, or its first key
+// when none is PEM encoded.
+func PublicKey(source []client.CertificateKey) *string {
+	for _, key := range source {
+		if key.Fmt != nil && *key.Fmt == "PEM" {
+			return key.Payload
+		}
+	}
+	if len(source) > 0 {
+		return source[0].Payload
+	}
+	return nil
+}
+
+// MapCertificateResource maps a certificate, the keystore and passwords are
+// not returned by the management API and are kept from the prior value. PEM
+// keys are stored as PKCS#12 keystores and keep their prior type.
+func MapCertificateResource(source *client.CertificateEntity, publicKey *string, target CertificateResourceModel) CertificateResourceModel {
+	target.CertificateId = convert.String(source.Id)
+	target.Id = types.StringValue(target.OrganizationId.ValueString() + ":" + target.EnvironmentId.ValueString() + ":" + target.DomainId.ValueString() + ":" + target.CertificateId.ValueString())
+	target.Name = convert.String(source.Name)
+	if certificateType := mapCertificateType(source.Type); target.Type.ValueString() != pemType || certificateType.ValueString() != "pkcs12" {
+		target.Type = certificateType
+	}
+	target.Status = types.StringNull()
+	if source.Status != nil {
+		target.Status = types.StringValue(string(*source.Status))
+	}
+	target.ExpiresAt = convert.Timestamp(source.ExpiresAt)
+	target.PublicKey = convert.String(publicKey)
+
+	if source.Configuration != nil {
+		var configuration map[string]interface{}
+		if json.Unmarshal([]byte(*source.Configuration), &configuration) == nil {
+			if alias, ok := configuration["alias"].(string); ok {
+				target.Alias = types.StringValue(alias)
+			}
+		}
+	}
+	return target
+}
+
+func GetCertificateResourceSchema() *schema.Schema {
+	return &schema.Schema{
+		MarkdownDescription: "Certificate resource, a keystore used by a security domain to sign tokens",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "TF identifier in the form organizationId:environmentId:domainId:certificateId",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"organization_id": schema.StringAttribute{
+				MarkdownDescription: "Organization id",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"environment_id": schema.StringAttribute{
+				MarkdownDescription: "Environment id",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"domain_id": schema.StringAttribute{
+				MarkdownDescription: "Domain id",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"certificate_id": schema.StringAttribute{
+				MarkdownDescription: "Certificate id",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Certificate name",
+				Required:            true,
+			},
+			"type": schema.StringAttribute{
+				MarkdownDescription: "Certificate keystore type, one of `jks`, `pkcs12` or `pem`. A `pem` keystore holds a local RSA or EC private key followed by its certificate chain, it is uploaded as a PKCS#12 keystore",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.OneOf("jks", "pkcs12", pemType),
+				},
+			},
+			"keystore": schema.StringAttribute{
+				MarkdownDescription: "Certificate keystore content, base64 encoded such as with `filebase64()`",
+				Required:            true,
+				Sensitive:           true,
+			},
+			"alias": schema.StringAttribute{
+				MarkdownDescription: "Certificate key alias within the keystore",
+				Required:            true,
+			},
+			"store_password": schema.StringAttribute{
+				MarkdownDescription: "Certificate keystore password",
+				Required:            true,
+				Sensitive:           true,
+			},
+			"key_password": schema.StringAttribute{
+				MarkdownDescription: "Certificate key password",
+				Required:            true,
+				Sensitive:           true,
+			},
+			"status": schema.StringAttribute{
+				MarkdownDescription: "Certificate status, one of `VALID`, `WILL_EXPIRE`, `EXPIRED` or `RENEWED`",
+				Computed:            true,
+			},
+			"expires_at": schema.StringAttribute{
+				MarkdownDescription: "Certificate expiry date, in RFC 3339 format",
+				Computed:            true,
+			},
+			"public_key": schema.StringAttribute{
+				MarkdownDescription: "Certificate public key, PEM encoded. Null until the next refresh when it could not be read on creation",
+				Computed:            true,
+			},
+		},
+	}
+}
@@ -0,0 +1,142 @@
+package certificate
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/thornleyk/graviteeioam-service/client"
+	"golang.org/x/crypto/pkcs12"
+)
+
+func testCertificateResourceModel() CertificateResourceModel {
+	return CertificateResourceModel{
+		OrganizationId: types.StringValue("DEFAULT"),
+		EnvironmentId:  types.StringValue("DEFAULT"),
+		DomainId:       types.StringValue("domain"),
+		Name:           types.StringValue("signing"),
+		Type:           types.StringValue("jks"),
+		Keystore:       types.StringValue("a2V5c3RvcmU="),
+		Alias:          types.StringValue("signing"),
+		StorePassword:  types.StringValue("storepass"),
+		KeyPassword:    types.StringValue("keypass"),
+	}
+}
+
+func TestNewCertificateFromResource(t *testing.T) {
+	newCertificate, diags := NewCertificateFromResource(testCertificateResourceModel())
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+	if newCertificate.Type != "javakeystore-am-certificate" || newCertificate.Name != "signing" {
+		t.Errorf("unexpected certificate: %+v", newCertificate)
+	}
+
+	var configuration map[string]string
+	if err := json.Unmarshal([]byte(newCertificate.Configuration), &configuration); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if configuration["alias"] != "signing" || configuration["storepass"] != "storepass" || configuration["keypass"] != "keypass" {
+		t.Errorf("unexpected configuration: %v", configuration)
+	}
+	var file certificateFile
+	if err := json.Unmarshal([]byte(configuration["jks"]), &file); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if file.Name != "keystore.jks" || file.Size != 8 || file.Content != "a2V5c3RvcmU=" {
+		t.Errorf("unexpected keystore file: %+v", file)
+	}
+}
+
+func TestCertificateConfigurationRejectsInvalidKeystore(t *testing.T) {
+	data := testCertificateResourceModel()
+	data.Keystore = types.StringValue("not base64!")
+	if _, diags := CertificateConfiguration(data); !diags.HasError() {
+		t.Error("expected an invalid keystore to be rejected")
+	}
+}
+
+func TestNewCertificateFromResourceBundlesPEMKeys(t *testing.T) {
+	data := testCertificateResourceModel()
+	data.Type = types.StringValue("pem")
+	data.Keystore = types.StringValue(base64.StdEncoding.EncodeToString(testPEMKeyPair(t)))
+	newCertificate, diags := NewCertificateFromResource(data)
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+	if newCertificate.Type != "pkcs12-am-certificate" {
+		t.Errorf("unexpected certificate: %+v", newCertificate)
+	}
+
+	var configuration map[string]string
+	if err := json.Unmarshal([]byte(newCertificate.Configuration), &configuration); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	var file certificateFile
+	if err := json.Unmarshal([]byte(configuration["content"]), &file); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	keystore, err := base64.StdEncoding.DecodeString(file.Content)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if file.Name != "keystore.p12" || file.Size != len(keystore) {
+		t.Errorf("unexpected keystore file: %+v", file)
+	}
+	if _, err := pkcs12.ToPEM(keystore, "storepass"); err == nil {
+		t.Error("expected the key to be protected by the key password")
+	}
+
+	data.Keystore = types.StringValue(base64.StdEncoding.EncodeToString([]byte("not a key")))
+	if _, diags := CertificateConfiguration(data); !diags.HasError() {
+		t.Error("expected an invalid PEM key to be rejected")
+	}
+}
+
+func TestMapCertificateResource(t *testing.T) {
+	var source client.CertificateEntity
+	payload := `{
+		"id": "certificate",
+		"name": "signing",
+		"type": "pkcs12-am-certificate",
+		"status": "WILL_EXPIRE",
+		"expiresAt": 1700000000000,
+		"configuration": "{\"alias\":\"rotated\",\"storepass\":\"********\"}"
+	}`
+	if err := json.Unmarshal([]byte(payload), &source); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	data := testCertificateResourceModel()
+	publicKey := "-----BEGIN PUBLIC KEY-----"
+	target := MapCertificateResource(&source, &publicKey, data)
+	if target.Id.ValueString() != "DEFAULT:DEFAULT:domain:certificate" || target.Type.ValueString() != "pkcs12" || target.Alias.ValueString() != "rotated" {
+		t.Errorf("unexpected certificate: %+v", target)
+	}
+	if target.Status.ValueString() != "WILL_EXPIRE" || target.ExpiresAt.ValueString() != "2023-11-14T22:13:20Z" || target.PublicKey.ValueString() != publicKey {
+		t.Errorf("unexpected certificate details: %+v", target)
+	}
+	if target.Keystore != data.Keystore || target.StorePassword != data.StorePassword || target.KeyPassword != data.KeyPassword {
+		t.Errorf("expected the keystore and passwords to be kept, got %+v", target)
+	}
+
+	data.Type = types.StringValue("pem")
+	if target := MapCertificateResource(&source, &publicKey, data); target.Type.ValueString() != "pem" {
+		t.Errorf("expected the PEM type to be kept, got %s", target.Type)
+	}
+}
+
+func TestPublicKey(t *testing.T) {
+	ssh, pem := "ssh-rsa AAAA", "-----BEGIN PUBLIC KEY-----"
+	sshFmt, pemFmt := "SSH-RSA", "PEM"
+	if key := PublicKey([]client.CertificateKey{{Fmt: &sshFmt, Payload: &ssh}, {Fmt: &pemFmt, Payload: &pem}}); key == nil || *key != pem {
+		t.Errorf("expected the PEM key, got %v", key)
+	}
+	if key := PublicKey([]client.CertificateKey{{Fmt: &sshFmt, Payload: &ssh}}); key == nil || *key != ssh {
+		t.Errorf("expected the first key, got %v", key)
+	}
+	if PublicKey(nil) != nil {
+		t.Error("expected no key")
+	}
+}
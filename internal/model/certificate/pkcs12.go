@@ -0,0 +1,344 @@
+package certificate
+
+import (
+	"bytes"
+	"crypto"
+	"crypto/cipher"
+	"crypto/des"
+	"crypto/ecdsa"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha1"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/asn1"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"unicode/utf16"
+)
+
+// pkcs12Iterations is the iteration count of the key derivations, the
+// default of the Java keytool.
+const pkcs12Iterations = 10000
+
+var (
+	oidData                        = asn1.ObjectIdentifier{1, 2, 840, 113549, 1, 7, 1}
+	oidPKCS8ShroudedKeyBag         = asn1.ObjectIdentifier{1, 2, 840, 113549, 1, 12, 10, 1, 2}
+	oidCertBag                     = asn1.ObjectIdentifier{1, 2, 840, 113549, 1, 12, 10, 1, 3}
+	oidX509Certificate             = asn1.ObjectIdentifier{1, 2, 840, 113549, 1, 9, 22, 1}
+	oidFriendlyName                = asn1.ObjectIdentifier{1, 2, 840, 113549, 1, 9, 20}
+	oidLocalKeyID                  = asn1.ObjectIdentifier{1, 2, 840, 113549, 1, 9, 21}
+	oidPBEWithSHAAnd3KeyTripleDES  = asn1.ObjectIdentifier{1, 2, 840, 113549, 1, 12, 1, 3}
+	oidSHA1                        = asn1.ObjectIdentifier{1, 3, 14, 3, 2, 26}
+	errMissingPEMPrivateKey        = errors.New("no private key found, expected a PRIVATE KEY, RSA PRIVATE KEY or EC PRIVATE KEY block")
+	errMissingPEMCertificate       = errors.New("no certificate found, expected a CERTIFICATE block")
+	errMismatchedPEMCertificate    = errors.New("the first certificate does not match the private key")
+	errUnsupportedPEMKeyAlgorithm  = errors.New("unsupported private key, expected an RSA or EC key")
+	errEncryptedPEMPrivateKeyBlock = errors.New("encrypted private keys are not supported, decrypt the key first")
+)
+
+type pfx struct {
+	Version  int
+	AuthSafe contentInfo
+	MacData  macData
+}
+
+type contentInfo struct {
+	ContentType asn1.ObjectIdentifier
+	Content     asn1.RawValue
+}
+
+type macData struct {
+	Mac        digestInfo
+	MacSalt    []byte
+	Iterations int
+}
+
+type digestInfo struct {
+	Algorithm pkix.AlgorithmIdentifier
+	Digest    []byte
+}
+
+type safeBag struct {
+	Id         asn1.ObjectIdentifier
+	Value      asn1.RawValue
+	Attributes []pkcs12Attribute `asn1:"set,omitempty"`
+}
+
+type pkcs12Attribute struct {
+	Id    asn1.ObjectIdentifier
+	Value asn1.RawValue
+}
+
+type certBag struct {
+	Id   asn1.ObjectIdentifier
+	Data []byte `asn1:"tag:0,explicit"`
+}
+
+type encryptedPrivateKeyInfo struct {
+	Algorithm pkix.AlgorithmIdentifier
+	Data      []byte
+}
+
+type pbeParams struct {
+	Salt       []byte
+	Iterations int
+}
+
+// pemToPKCS12 bundles a PEM encoded RSA or EC private key and its
+// certificate chain, the certificate of the key first, into a PKCS#12
+// keystore. The key is stored under alias and encrypted with keyPassword,
+// the keystore is protected with storePassword.
+func pemToPKCS12(source []byte, alias string, storePassword string, keyPassword string) ([]byte, error) {
+	key, certificates, err := decodePEMKeyPair(source)
+	if err != nil {
+		return nil, err
+	}
+
+	localKeyID := sha1.Sum(certificates[0].Raw)
+	attributes, err := bagAttributes(alias, localKeyID[:])
+	if err != nil {
+		return nil, err
+	}
+
+	keyBag, err := shroudedKeyBag(key, keyPassword, attributes)
+	if err != nil {
+		return nil, err
+	}
+	var certificateBags []safeBag
+	for i, certificate := range certificates {
+		bag, err := certificateBag(certificate)
+		if err != nil {
+			return nil, err
+		}
+		// The chain is matched with the key through the first certificate.
+		if i == 0 {
+			bag.Attributes = attributes
+		}
+		certificateBags = append(certificateBags, bag)
+	}
+
+	// The key and the certificates are stored apart, as keytool does.
+	var contents []contentInfo
+	for _, bags := range [][]safeBag{{keyBag}, certificateBags} {
+		safeContents, err := asn1.Marshal(bags)
+		if err != nil {
+			return nil, err
+		}
+		data, err := dataContentInfo(safeContents)
+		if err != nil {
+			return nil, err
+		}
+		contents = append(contents, data)
+	}
+	authenticatedSafe, err := asn1.Marshal(contents)
+	if err != nil {
+		return nil, err
+	}
+	authSafe, err := dataContentInfo(authenticatedSafe)
+	if err != nil {
+		return nil, err
+	}
+
+	mac, err := authenticatedSafeMac(authenticatedSafe, storePassword)
+	if err != nil {
+		return nil, err
+	}
+	return asn1.Marshal(pfx{Version: 3, AuthSafe: authSafe, MacData: mac})
+}
+
+// decodePEMKeyPair returns the private key and the certificates of PEM
+// blocks, in order.
+func decodePEMKeyPair(source []byte) (crypto.PrivateKey, []*x509.Certificate, error) {
+	var key crypto.PrivateKey
+	var certificates []*x509.Certificate
+	for {
+		var block *pem.Block
+		block, source = pem.Decode(source)
+		if block == nil {
+			break
+		}
+		var err error
+		switch block.Type {
+		case "CERTIFICATE":
+			var certificate *x509.Certificate
+			certificate, err = x509.ParseCertificate(block.Bytes)
+			certificates = append(certificates, certificate)
+		case "PRIVATE KEY":
+			key, err = x509.ParsePKCS8PrivateKey(block.Bytes)
+		case "RSA PRIVATE KEY":
+			key, err = x509.ParsePKCS1PrivateKey(block.Bytes)
+		case "EC PRIVATE KEY":
+			key, err = x509.ParseECPrivateKey(block.Bytes)
+		case "ENCRYPTED PRIVATE KEY":
+			err = errEncryptedPEMPrivateKeyBlock
+		}
+		if err != nil {
+			return nil, nil, fmt.Errorf("invalid %s block: %w", block.Type, err)
+		}
+	}
+
+	if key == nil {
+		return nil, nil, errMissingPEMPrivateKey
+	}
+	if len(certificates) == 0 {
+		return nil, nil, errMissingPEMCertificate
+	}
+	switch privateKey := key.(type) {
+	case *rsa.PrivateKey:
+		if !privateKey.PublicKey.Equal(certificates[0].PublicKey) {
+			return nil, nil, errMismatchedPEMCertificate
+		}
+	case *ecdsa.PrivateKey:
+		if !privateKey.PublicKey.Equal(certificates[0].PublicKey) {
+			return nil, nil, errMismatchedPEMCertificate
+		}
+	default:
+		return nil, nil, errUnsupportedPEMKeyAlgorithm
+	}
+	return key, certificates, nil
+}
+
+func bagAttributes(alias string, localKeyID []byte) ([]pkcs12Attribute, error) {
+	friendlyName, err := asn1.Marshal(asn1.RawValue{Tag: asn1.TagBMPString, Bytes: bmpString(alias, false)})
+	if err != nil {
+		return nil, err
+	}
+	keyID, err := asn1.Marshal(localKeyID)
+	if err != nil {
+		return nil, err
+	}
+	return []pkcs12Attribute{
+		{Id: oidFriendlyName, Value: asn1.RawValue{Class: asn1.ClassUniversal, Tag: asn1.TagSet, IsCompound: true, Bytes: friendlyName}},
+		{Id: oidLocalKeyID, Value: asn1.RawValue{Class: asn1.ClassUniversal, Tag: asn1.TagSet, IsCompound: true, Bytes: keyID}},
+	}, nil
+}
+
+func shroudedKeyBag(key crypto.PrivateKey, password string, attributes []pkcs12Attribute) (safeBag, error) {
+	privateKey, err := x509.MarshalPKCS8PrivateKey(key)
+	if err != nil {
+		return safeBag{}, err
+	}
+
+	salt := make([]byte, 20)
+	if _, err := rand.Read(salt); err != nil {
+		return safeBag{}, err
+	}
+	encodedPassword := bmpString(password, true)
+	block, err := des.NewTripleDESCipher(pkcs12KDF(salt, encodedPassword, 1, 24))
+	if err != nil {
+		return safeBag{}, err
+	}
+	padding := block.BlockSize() - len(privateKey)%block.BlockSize()
+	encrypted := append(privateKey, bytes.Repeat([]byte{byte(padding)}, padding)...)
+	cipher.NewCBCEncrypter(block, pkcs12KDF(salt, encodedPassword, 2, block.BlockSize())).CryptBlocks(encrypted, encrypted)
+
+	params, err := asn1.Marshal(pbeParams{Salt: salt, Iterations: pkcs12Iterations})
+	if err != nil {
+		return safeBag{}, err
+	}
+	value, err := asn1.Marshal(encryptedPrivateKeyInfo{
+		Algorithm: pkix.AlgorithmIdentifier{Algorithm: oidPBEWithSHAAnd3KeyTripleDES, Parameters: asn1.RawValue{FullBytes: params}},
+		Data:      encrypted,
+	})
+	if err != nil {
+		return safeBag{}, err
+	}
+	return safeBag{Id: oidPKCS8ShroudedKeyBag, Value: asn1.RawValue{FullBytes: explicitTag(value)}, Attributes: attributes}, nil
+}
+
+func certificateBag(certificate *x509.Certificate) (safeBag, error) {
+	value, err := asn1.Marshal(certBag{Id: oidX509Certificate, Data: certificate.Raw})
+	if err != nil {
+		return safeBag{}, err
+	}
+	return safeBag{Id: oidCertBag, Value: asn1.RawValue{FullBytes: explicitTag(value)}}, nil
+}
+
+func dataContentInfo(content []byte) (contentInfo, error) {
+	data, err := asn1.Marshal(content)
+	if err != nil {
+		return contentInfo{}, err
+	}
+	return contentInfo{ContentType: oidData, Content: asn1.RawValue{FullBytes: explicitTag(data)}}, nil
+}
+
+func authenticatedSafeMac(authenticatedSafe []byte, password string) (macData, error) {
+	salt := make([]byte, 20)
+	if _, err := rand.Read(salt); err != nil {
+		return macData{}, err
+	}
+	mac := hmac.New(sha1.New, pkcs12KDF(salt, bmpString(password, true), 3, sha1.Size))
+	mac.Write(authenticatedSafe)
+	return macData{
+		Mac: digestInfo{
+			Algorithm: pkix.AlgorithmIdentifier{Algorithm: oidSHA1, Parameters: asn1.NullRawValue},
+			Digest:    mac.Sum(nil),
+		},
+		MacSalt:    salt,
+		Iterations: pkcs12Iterations,
+	}, nil
+}
+
+// explicitTag wraps an encoded value in a [0] EXPLICIT tag.
+func explicitTag(value []byte) []byte {
+	tagged, _ := asn1.Marshal(asn1.RawValue{Class: asn1.ClassContextSpecific, Tag: 0, IsCompound: true, Bytes: value})
+	return tagged
+}
+
+// bmpString encodes a password or a friendly name as a big endian UTF-16
+// string, passwords are NUL terminated.
+func bmpString(source string, terminated bool) []byte {
+	encoded := []byte{}
+	for _, unit := range utf16.Encode([]rune(source)) {
+		encoded = append(encoded, byte(unit>>8), byte(unit))
+	}
+	if terminated {
+		encoded = append(encoded, 0, 0)
+	}
+	return encoded
+}
+
+// pkcs12KDF derives size bytes of key material with the SHA-1 based key
+// derivation of RFC 7292 appendix B.2, id selects the key, IV or MAC key.
+func pkcs12KDF(salt []byte, password []byte, id byte, size int) []byte {
+	const v = 64
+	fill := func(source []byte) []byte {
+		if len(source) == 0 {
+			return nil
+		}
+		filled := make([]byte, v*((len(source)+v-1)/v))
+		for i := range filled {
+			filled[i] = source[i%len(source)]
+		}
+		return filled
+	}
+
+	d := bytes.Repeat([]byte{id}, v)
+	input := append(fill(salt), fill(password)...)
+	derived := []byte{}
+	for len(derived) < size {
+		hash := sha1.New()
+		hash.Write(d)
+		hash.Write(input)
+		a := hash.Sum(nil)
+		for i := 1; i < pkcs12Iterations; i++ {
+			sum := sha1.Sum(a)
+			a = sum[:]
+		}
+		derived = append(derived, a...)
+
+		b := fill(a)
+		for j := 0; j < len(input); j += v {
+			carry := 1
+			for k := v - 1; k >= 0; k-- {
+				carry += int(input[j+k]) + int(b[k])
+				input[j+k] = byte(carry)
+				carry >>= 8
+			}
+		}
+	}
+	return derived[:size]
+}
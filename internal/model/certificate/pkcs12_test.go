@@ -0,0 +1,81 @@
+package certificate
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"testing"
+	"time"
+
+	"golang.org/x/crypto/pkcs12"
+)
+
+func testPEMKeyPair(t *testing.T) []byte {
+	t.Helper()
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "signing"},
+		NotBefore:    time.Now(),
+		NotAfter:     time.Now().Add(time.Hour),
+	}
+	certificate, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	privateKey, err := x509.MarshalECPrivateKey(key)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	return append(
+		pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: privateKey}),
+		pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: certificate})...,
+	)
+}
+
+func TestPemToPKCS12(t *testing.T) {
+	keystore, err := pemToPKCS12(testPEMKeyPair(t), "signing", "changeit", "changeit")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	blocks, err := pkcs12.ToPEM(keystore, "changeit")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if len(blocks) != 2 || blocks[0].Type != "PRIVATE KEY" || blocks[1].Type != "CERTIFICATE" {
+		t.Fatalf("unexpected keystore entries: %v", blocks)
+	}
+	if blocks[0].Headers["friendlyName"] != "signing" || blocks[0].Headers["localKeyId"] != blocks[1].Headers["localKeyId"] {
+		t.Errorf("unexpected key attributes: %v, %v", blocks[0].Headers, blocks[1].Headers)
+	}
+
+	if _, err := pkcs12.ToPEM(keystore, "wrong"); err == nil {
+		t.Error("expected the keystore to be protected by its password")
+	}
+}
+
+func TestPemToPKCS12RejectsInvalidKeyPairs(t *testing.T) {
+	keyPair := testPEMKeyPair(t)
+	otherKeyPair := testPEMKeyPair(t)
+	key, rest := pem.Decode(keyPair)
+	otherKey, _ := pem.Decode(otherKeyPair)
+
+	for name, source := range map[string][]byte{
+		"missing key":         rest,
+		"missing certificate": pem.EncodeToMemory(key),
+		"mismatched key":      append(pem.EncodeToMemory(otherKey), rest...),
+		"encrypted key":       append(pem.EncodeToMemory(&pem.Block{Type: "ENCRYPTED PRIVATE KEY", Bytes: key.Bytes}), rest...),
+	} {
+		if _, err := pemToPKCS12(source, "signing", "changeit", "changeit"); err == nil {
+			t.Errorf("%s: expected the key pair to be rejected", name)
+		}
+	}
+}
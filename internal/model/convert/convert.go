@@ -0,0 +1,254 @@
+// Package convert holds the nil-safe conversions between the optional pointer
+// fields of the generated management API client and Terraform values. Every
+// conversion to a Terraform value returns a null value when the source is nil,
+// and every conversion to a pointer returns nil when the value is null or
+// unknown.
+package convert
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"reflect"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// String converts an optional string.
+func String(source *string) types.String {
+	return types.StringPointerValue(source)
+}
+
+// Bool converts an optional boolean.
+func Bool(source *bool) types.Bool {
+	return types.BoolPointerValue(source)
+}
+
+// Int64 converts an optional 64 bits integer.
+func Int64(source *int64) types.Int64 {
+	return types.Int64PointerValue(source)
+}
+
+// Int32 converts an optional 32 bits integer, as Terraform numbers are 64 bits.
+func Int32(source *int32) types.Int64 {
+	if source == nil {
+		return types.Int64Null()
+	}
+	return types.Int64Value(int64(*source))
+}
+
+// StringList converts an optional list of strings.
+func StringList(source *[]string) types.List {
+	if source == nil {
+		return types.ListNull(types.StringType)
+	}
+	elements := make([]attr.Value, 0, len(*source))
+	for _, value := range *source {
+		elements = append(elements, types.StringValue(value))
+	}
+	return types.ListValueMust(types.StringType, elements)
+}
+
+// StringSet converts an optional set of strings.
+func StringSet(source *[]string) types.Set {
+	if source == nil {
+		return types.SetNull(types.StringType)
+	}
+	elements := make([]attr.Value, 0, len(*source))
+	for _, value := range *source {
+		elements = append(elements, types.StringValue(value))
+	}
+	return types.SetValueMust(types.StringType, elements)
+}
+
+// StringMap converts an optional map of strings.
+func StringMap(source *map[string]string) types.Map {
+	if source == nil {
+		return types.MapNull(types.StringType)
+	}
+	elements := make(map[string]attr.Value, len(*source))
+	for key, value := range *source {
+		elements[key] = types.StringValue(value)
+	}
+	return types.MapValueMust(types.StringType, elements)
+}
+
+// StringListMap converts an optional map of lists of strings, such as the
+// identity provider role mappers.
+func StringListMap(source *map[string][]string) types.Map {
+	elementType := types.ListType{ElemType: types.StringType}
+	if source == nil {
+		return types.MapNull(elementType)
+	}
+	elements := make(map[string]attr.Value, len(*source))
+	for key, values := range *source {
+		elements[key] = StringList(&values)
+	}
+	return types.MapValueMust(elementType, elements)
+}
+
+// JSON converts an optional JSON document, keeping the prior value when both
+// documents are semantically equal so that formatting differences do not
+// show as a diff.
+func JSON(source *string, prior types.String) types.String {
+	if source == nil {
+		return types.StringNull()
+	}
+	if !prior.IsNull() && !prior.IsUnknown() && JSONEqual(*source, prior.ValueString()) {
+		return prior
+	}
+	return types.StringValue(*source)
+}
+
+// JSONEqual reports whether two JSON documents are semantically equal.
+func JSONEqual(a string, b string) bool {
+	var decodedA, decodedB interface{}
+	if json.Unmarshal([]byte(a), &decodedA) != nil || json.Unmarshal([]byte(b), &decodedB) != nil {
+		return a == b
+	}
+	return reflect.DeepEqual(decodedA, decodedB)
+}
+
+// SensitiveValue replaces the sensitive fields of the plugin configurations
+// returned by the management API.
+const SensitiveValue = "********"
+
+// UnmaskedJSON returns a plugin configuration with its masked sensitive
+// fields replaced by the prior values of the same fields, so that masking
+// does not show as a diff. Fields without a prior value stay masked.
+func UnmaskedJSON(source *string, prior types.String) *string {
+	if source == nil || prior.IsNull() || prior.IsUnknown() || !strings.Contains(*source, SensitiveValue) {
+		return source
+	}
+	var decoded, decodedPrior interface{}
+	if json.Unmarshal([]byte(*source), &decoded) != nil || json.Unmarshal([]byte(prior.ValueString()), &decodedPrior) != nil {
+		return source
+	}
+	encoded, err := json.Marshal(unmask(decoded, decodedPrior))
+	if err != nil {
+		return source
+	}
+	unmasked := string(encoded)
+	return &unmasked
+}
+
+func unmask(source interface{}, prior interface{}) interface{} {
+	switch value := source.(type) {
+	case string:
+		if value == SensitiveValue && prior != nil {
+			return prior
+		}
+	case map[string]interface{}:
+		priorObject, _ := prior.(map[string]interface{})
+		for key, field := range value {
+			value[key] = unmask(field, priorObject[key])
+		}
+	case []interface{}:
+		priorArray, _ := prior.([]interface{})
+		for i, item := range value {
+			var priorItem interface{}
+			if i < len(priorArray) {
+				priorItem = priorArray[i]
+			}
+			value[i] = unmask(item, priorItem)
+		}
+	}
+	return source
+}
+
+// NormalizedJSON converts an optional JSON document to its compact form with
+// sorted object keys, documents that cannot be decoded are kept as is.
+func NormalizedJSON(source *string) types.String {
+	if source == nil {
+		return types.StringNull()
+	}
+	decoder := json.NewDecoder(strings.NewReader(*source))
+	decoder.UseNumber()
+	var decoded interface{}
+	if err := decoder.Decode(&decoded); err != nil {
+		return types.StringValue(*source)
+	}
+	var buffer bytes.Buffer
+	encoder := json.NewEncoder(&buffer)
+	encoder.SetEscapeHTML(false)
+	if err := encoder.Encode(decoded); err != nil {
+		return types.StringValue(*source)
+	}
+	return types.StringValue(strings.TrimSuffix(buffer.String(), "\n"))
+}
+
+// Timestamp converts an optional epoch milliseconds timestamp to RFC 3339.
+func Timestamp(source *int64) types.String {
+	if source == nil {
+		return types.StringNull()
+	}
+	return types.StringValue(time.UnixMilli(*source).UTC().Format(time.RFC3339))
+}
+
+// OptionalString returns the value of a known string.
+func OptionalString(source types.String) *string {
+	if source.IsNull() || source.IsUnknown() {
+		return nil
+	}
+	return source.ValueStringPointer()
+}
+
+// OptionalBool returns the value of a known boolean.
+func OptionalBool(source types.Bool) *bool {
+	if source.IsNull() || source.IsUnknown() {
+		return nil
+	}
+	return source.ValueBoolPointer()
+}
+
+// OptionalInt32 returns the value of a known number, for the 32 bits integers
+// of the management API.
+func OptionalInt32(source types.Int64) *int32 {
+	if source.IsNull() || source.IsUnknown() {
+		return nil
+	}
+	value := int32(source.ValueInt64())
+	return &value
+}
+
+// stringCollection is implemented by types.List and types.Set.
+type stringCollection interface {
+	IsNull() bool
+	IsUnknown() bool
+	ElementsAs(ctx context.Context, target interface{}, allowUnhandled bool) diag.Diagnostics
+}
+
+// OptionalStrings returns the elements of a known list or set of strings.
+func OptionalStrings(ctx context.Context, source stringCollection) (*[]string, diag.Diagnostics) {
+	if source.IsNull() || source.IsUnknown() {
+		return nil, nil
+	}
+	values := []string{}
+	diags := source.ElementsAs(ctx, &values, false)
+	return &values, diags
+}
+
+// OptionalStringMap returns the elements of a known map of strings.
+func OptionalStringMap(ctx context.Context, source types.Map) (*map[string]string, diag.Diagnostics) {
+	if source.IsNull() || source.IsUnknown() {
+		return nil, nil
+	}
+	values := map[string]string{}
+	diags := source.ElementsAs(ctx, &values, false)
+	return &values, diags
+}
+
+// OptionalStringListMap returns the elements of a known map of lists of
+// strings.
+func OptionalStringListMap(ctx context.Context, source types.Map) (*map[string][]string, diag.Diagnostics) {
+	if source.IsNull() || source.IsUnknown() {
+		return nil, nil
+	}
+	values := map[string][]string{}
+	diags := source.ElementsAs(ctx, &values, false)
+	return &values, diags
+}
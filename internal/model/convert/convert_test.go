@@ -0,0 +1,154 @@
+package convert
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestConversionsReturnNullOnNil(t *testing.T) {
+	if !String(nil).IsNull() {
+		t.Error("expected String(nil) to be null")
+	}
+	if !Bool(nil).IsNull() {
+		t.Error("expected Bool(nil) to be null")
+	}
+	if !Int64(nil).IsNull() {
+		t.Error("expected Int64(nil) to be null")
+	}
+	if !Int32(nil).IsNull() {
+		t.Error("expected Int32(nil) to be null")
+	}
+	if list := StringList(nil); !list.IsNull() || !list.ElementType(nil).Equal(types.StringType) {
+		t.Errorf("expected StringList(nil) to be a null list of strings, got %s", list)
+	}
+	if set := StringSet(nil); !set.IsNull() || !set.ElementType(nil).Equal(types.StringType) {
+		t.Errorf("expected StringSet(nil) to be a null set of strings, got %s", set)
+	}
+	if m := StringMap(nil); !m.IsNull() || !m.ElementType(nil).Equal(types.StringType) {
+		t.Errorf("expected StringMap(nil) to be a null map of strings, got %s", m)
+	}
+	if !Timestamp(nil).IsNull() {
+		t.Error("expected Timestamp(nil) to be null")
+	}
+}
+
+func TestConversionsKeepValues(t *testing.T) {
+	name, enabled, count, age := "test", false, int64(42), int32(7)
+	if String(&name).ValueString() != "test" {
+		t.Errorf("unexpected string: %s", String(&name))
+	}
+	if value := Bool(&enabled); value.IsNull() || value.ValueBool() {
+		t.Errorf("expected false to be kept, got %s", value)
+	}
+	if Int64(&count).ValueInt64() != 42 || Int32(&age).ValueInt64() != 7 {
+		t.Errorf("unexpected integers: %s, %s", Int64(&count), Int32(&age))
+	}
+
+	empty := []string{}
+	if list := StringList(&empty); list.IsNull() || len(list.Elements()) != 0 {
+		t.Errorf("expected an empty list to stay empty, got %s", list)
+	}
+	values := []string{"a", "b"}
+	if list := StringList(&values); !list.Equal(types.ListValueMust(types.StringType, []attr.Value{types.StringValue("a"), types.StringValue("b")})) {
+		t.Errorf("unexpected list: %s", list)
+	}
+	mapping := map[string]string{"email": "mail"}
+	if m := StringMap(&mapping); m.Elements()["email"] != types.StringValue("mail") {
+		t.Errorf("unexpected map: %s", m)
+	}
+
+	timestamp := int64(1700000000000)
+	if Timestamp(&timestamp).ValueString() != "2023-11-14T22:13:20Z" {
+		t.Errorf("unexpected timestamp: %s", Timestamp(&timestamp))
+	}
+}
+
+func TestOptionalConversions(t *testing.T) {
+	if OptionalString(types.StringNull()) != nil || OptionalString(types.StringUnknown()) != nil {
+		t.Error("expected null and unknown strings to be nil")
+	}
+	if OptionalBool(types.BoolUnknown()) != nil || OptionalInt32(types.Int64Null()) != nil {
+		t.Error("expected null and unknown values to be nil")
+	}
+	if value := OptionalInt32(types.Int64Value(3600)); value == nil || *value != 3600 {
+		t.Errorf("unexpected number: %v", value)
+	}
+
+	ctx := context.Background()
+	if values, diags := OptionalStrings(ctx, types.SetUnknown(types.StringType)); values != nil || diags.HasError() {
+		t.Errorf("expected an unknown set to be nil, got %v %v", values, diags)
+	}
+	values, diags := OptionalStrings(ctx, types.ListValueMust(types.StringType, []attr.Value{types.StringValue("a")}))
+	if diags.HasError() || values == nil || len(*values) != 1 || (*values)[0] != "a" {
+		t.Errorf("unexpected values: %v %v", values, diags)
+	}
+	if values, _ := OptionalStrings(ctx, types.ListValueMust(types.StringType, nil)); values == nil || len(*values) != 0 {
+		t.Errorf("expected an empty list to be kept, got %v", values)
+	}
+}
+
+func TestStringListMap(t *testing.T) {
+	if m := StringListMap(nil); !m.IsNull() {
+		t.Errorf("expected StringListMap(nil) to be null, got %s", m)
+	}
+	source := map[string][]string{"admin": {"groups=admins", "username=root"}}
+	m := StringListMap(&source)
+
+	values, diags := OptionalStringListMap(context.Background(), m)
+	if diags.HasError() || values == nil || len((*values)["admin"]) != 2 || (*values)["admin"][1] != "username=root" {
+		t.Errorf("expected the map to round trip, got %v %v", values, diags)
+	}
+}
+
+func TestJSON(t *testing.T) {
+	prior := types.StringValue(`{"b": [1, 2], "a": "x"}`)
+	source := `{"a":"x","b":[1,2]}`
+	if value := JSON(&source, prior); value != prior {
+		t.Errorf("expected the prior value to be kept for an equal document, got %s", value)
+	}
+
+	changed := `{"a":"y","b":[1,2]}`
+	if value := JSON(&changed, prior); value.ValueString() != changed {
+		t.Errorf("expected a changed document to be returned, got %s", value)
+	}
+	if value := JSON(&source, types.StringUnknown()); value.ValueString() != source {
+		t.Errorf("expected the document to be returned without a prior value, got %s", value)
+	}
+	if !JSON(nil, prior).IsNull() {
+		t.Error("expected JSON(nil) to be null")
+	}
+}
+
+func TestUnmaskedJSON(t *testing.T) {
+	prior := types.StringValue(`{"accountSid": "AC1", "authToken": "secret", "headers": [{"name": "x-api-key", "value": "key"}]}`)
+	source := `{"accountSid":"AC1","authToken":"********","headers":[{"name":"x-api-key","value":"********"}],"password":"********"}`
+	unmasked := UnmaskedJSON(&source, prior)
+	if !JSONEqual(*unmasked, `{"accountSid":"AC1","authToken":"secret","headers":[{"name":"x-api-key","value":"key"}],"password":"********"}`) {
+		t.Errorf("unexpected unmasked document: %s", *unmasked)
+	}
+
+	if value := UnmaskedJSON(&source, types.StringNull()); value != &source {
+		t.Errorf("expected the document to be kept without a prior value, got %s", *value)
+	}
+	if UnmaskedJSON(nil, prior) != nil {
+		t.Error("expected UnmaskedJSON(nil) to be nil")
+	}
+}
+
+func TestNormalizedJSON(t *testing.T) {
+	source := `{"url": "https://example.com/?a=1&b=2", "b": [1, 2.50], "a": {"d": null, "c": true}}`
+	if value := NormalizedJSON(&source).ValueString(); value != `{"a":{"c":true,"d":null},"b":[1,2.50],"url":"https://example.com/?a=1&b=2"}` {
+		t.Errorf("unexpected normalized document: %s", value)
+	}
+
+	invalid := `{not json`
+	if value := NormalizedJSON(&invalid).ValueString(); value != invalid {
+		t.Errorf("expected an invalid document to be kept, got %s", value)
+	}
+	if !NormalizedJSON(nil).IsNull() {
+		t.Error("expected NormalizedJSON(nil) to be null")
+	}
+}
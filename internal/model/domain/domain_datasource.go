@@ -4,47 +4,55 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/thornleyk/graviteeioam-service/client"
+	"github.com/thornleyk/terraform-provider-graviteeioam/internal/model/convert"
 )
 
 type DomainClientRegistrationSettings struct {
-	AllowLocalhostRedirectURI          types.Bool `tfsdk:"allow_localhost_redirect_uri"`
-	AllowHTTPSchemeRedirectURI         types.Bool `tfsdk:"allow_http_scheme_redirect_uri"`
-	AllowWildCardRedirectURI           types.Bool `tfsdk:"allow_wild_card_redirect_uri"`
-	IsDynamicClientRegistrationEnabled types.Bool `tfsdk:"is_dynamic_client_registration_enabled"`
-	IsAllowedScopesEnabled             types.Bool `tfsdk:"is_allowed_scopes_enabled"`
-	IsClientTemplateEnabled            types.Bool `tfsdk:"is_client_template_enabled"`
+	AllowLocalhostRedirectURI              types.Bool `tfsdk:"allow_localhost_redirect_uri"`
+	AllowHTTPSchemeRedirectURI             types.Bool `tfsdk:"allow_http_scheme_redirect_uri"`
+	AllowWildCardRedirectURI               types.Bool `tfsdk:"allow_wild_card_redirect_uri"`
+	IsDynamicClientRegistrationEnabled     types.Bool `tfsdk:"is_dynamic_client_registration_enabled"`
+	IsOpenDynamicClientRegistrationEnabled types.Bool `tfsdk:"is_open_dynamic_client_registration_enabled"`
+	IsAllowedScopesEnabled                 types.Bool `tfsdk:"is_allowed_scopes_enabled"`
+	IsClientTemplateEnabled                types.Bool `tfsdk:"is_client_template_enabled"`
+	AllowedScopes                          types.List `tfsdk:"allowed_scopes"`
+	DefaultScopes                          types.List `tfsdk:"default_scopes"`
 }
 
 type DomainSecurityProfileSettings struct {
-	EnablePlanFAPI   types.Bool `tfsdk:"enable_plain_fapi"`
+	EnablePlainFAPI  types.Bool `tfsdk:"enable_plain_fapi"`
 	EnableFAPIBrazil types.Bool `tfsdk:"enable_fapi_brazil"`
 }
 
 type DomainCIBASettings struct {
-	Enabled              types.Bool   `tfsdk:"enabled"`
-	AuthReqExpiry        types.Number `tfsdk:"auth_req_expiry"`
-	TokenReqInterval     types.Number `tfsdk:"token_req_interval"`
-	BindingMessageLength types.Number `tfsdk:"binding_message_length"`
+	Enabled              types.Bool  `tfsdk:"enabled"`
+	AuthReqExpiry        types.Int64 `tfsdk:"auth_req_expiry"`
+	TokenReqInterval     types.Int64 `tfsdk:"token_req_interval"`
+	BindingMessageLength types.Int64 `tfsdk:"binding_message_length"`
+	DeviceNotifiers      types.List  `tfsdk:"device_notifiers"`
 }
 
 type DomainOIDC struct {
-	ClientRegistrationSettings types.String `tfsdk:"client_registration_settings"`
-	SecurityProfileSettings    types.String `tfsdk:"security_profile_settings"`
-	RedirectURIStrictMatching  types.Bool   `tfsdk:"redirect_uri_strict_matching"`
-	CIBASettings               types.String `tfsdk:"ciba_settings"`
+	ClientRegistrationSettings *DomainClientRegistrationSettings `tfsdk:"client_registration_settings"`
+	SecurityProfileSettings    *DomainSecurityProfileSettings    `tfsdk:"security_profile_settings"`
+	RedirectURIStrictMatching  types.Bool                        `tfsdk:"redirect_uri_strict_matching"`
+	PostLogoutRedirectURIs     types.List                        `tfsdk:"post_logout_redirect_uris"`
+	RequestURIs                types.List                        `tfsdk:"request_uris"`
+	CIBASettings               *DomainCIBASettings               `tfsdk:"ciba_settings"`
 }
 
 type DomainLoginSettings struct {
-	Inherited                          types.String `tfsdk:"inherited"`
-	ForgotPasswordEnabled              types.String `tfsdk:"forgot_password_enabled"`
-	RegisterEnabled                    types.Bool   `tfsdk:"register_enabled"`
-	RememberMeEnabled                  types.Bool   `tfsdk:"remember_me_enabled"`
-	PasswordlessEnabled                types.String `tfsdk:"passwordless_enabled"`
-	PasswordlessRememberDeviceEnabled  types.String `tfsdk:"passwordless_remember_device_enabled"`
-	PasswordlessEnforcePasswordEnabled types.String `tfsdk:"passwordless_enforce_password_enabled"`
-	PasswordlessDeviceNamingEnabled    types.String `tfsdk:"passwordless_device_naming_enabled"`
-	HideForm                           types.String `tfsdk:"hide_form"`
-	IdentifierFirstEnabled             types.String `tfsdk:"identifier_first_enabled"`
+	Inherited                          types.Bool  `tfsdk:"inherited"`
+	ForgotPasswordEnabled              types.Bool  `tfsdk:"forgot_password_enabled"`
+	RegisterEnabled                    types.Bool  `tfsdk:"register_enabled"`
+	RememberMeEnabled                  types.Bool  `tfsdk:"remember_me_enabled"`
+	PasswordlessEnabled                types.Bool  `tfsdk:"passwordless_enabled"`
+	PasswordlessRememberDeviceEnabled  types.Bool  `tfsdk:"passwordless_remember_device_enabled"`
+	PasswordlessEnforcePasswordEnabled types.Bool  `tfsdk:"passwordless_enforce_password_enabled"`
+	PasswordlessEnforcePasswordMaxAge  types.Int64 `tfsdk:"passwordless_enforce_password_max_age"`
+	EnforcePasswordPolicyEnabled       types.Bool  `tfsdk:"enforce_password_policy_enabled"`
+	HideForm                           types.Bool  `tfsdk:"hide_form"`
+	IdentifierFirstEnabled             types.Bool  `tfsdk:"identifier_first_enabled"`
 }
 
 type DomainDataSourceModel struct {
@@ -62,15 +70,86 @@ type DomainDataSourceModel struct {
 
 func MapDomainDataSource(source *client.Domain, target DomainDataSourceModel) (DomainDataSourceModel, error) {
 	target.Id = target.DomainId
-	target.Hrid = types.StringValue(*source.Hrid)
-	target.Name = types.StringValue(*source.Name)
-	target.Description = types.StringValue(*source.Description)
-	target.Enabled = types.BoolValue(*source.Enabled)
-	target.Master = types.BoolValue(*source.Master)
-	target.VHostMode = types.BoolValue(*source.VhostMode)
+	target.Hrid = convert.String(source.Hrid)
+	target.Name = convert.String(source.Name)
+	target.Description = convert.String(source.Description)
+	target.Enabled = convert.Bool(source.Enabled)
+	target.Master = convert.Bool(source.Master)
+	target.VHostMode = convert.Bool(source.VhostMode)
+	target.DomainOIDC = mapDomainOIDC(source.Oidc)
+	target.DomainLoginSettings = mapDomainLoginSettings(source.LoginSettings)
 	return target, nil
 }
 
+func mapDomainOIDC(source *client.OIDCSettings) *DomainOIDC {
+	if source == nil {
+		return nil
+	}
+	target := &DomainOIDC{
+		RedirectURIStrictMatching: convert.Bool(source.RedirectUriStrictMatching),
+		PostLogoutRedirectURIs:    convert.StringList(source.PostLogoutRedirectUris),
+		RequestURIs:               convert.StringList(source.RequestUris),
+	}
+	if settings := source.ClientRegistrationSettings; settings != nil {
+		target.ClientRegistrationSettings = &DomainClientRegistrationSettings{
+			AllowLocalhostRedirectURI:              convert.Bool(settings.AllowLocalhostRedirectUri),
+			AllowHTTPSchemeRedirectURI:             convert.Bool(settings.AllowHttpSchemeRedirectUri),
+			AllowWildCardRedirectURI:               convert.Bool(settings.AllowWildCardRedirectUri),
+			IsDynamicClientRegistrationEnabled:     convert.Bool(settings.DynamicClientRegistrationEnabled),
+			IsOpenDynamicClientRegistrationEnabled: convert.Bool(settings.OpenDynamicClientRegistrationEnabled),
+			IsAllowedScopesEnabled:                 convert.Bool(settings.AllowedScopesEnabled),
+			IsClientTemplateEnabled:                convert.Bool(settings.ClientTemplateEnabled),
+			AllowedScopes:                          convert.StringList(settings.AllowedScopes),
+			DefaultScopes:                          convert.StringList(settings.DefaultScopes),
+		}
+	}
+	if settings := source.SecurityProfileSettings; settings != nil {
+		target.SecurityProfileSettings = &DomainSecurityProfileSettings{
+			EnablePlainFAPI:  convert.Bool(settings.EnablePlainFapi),
+			EnableFAPIBrazil: convert.Bool(settings.EnableFapiBrazil),
+		}
+	}
+	if settings := source.CibaSettings; settings != nil {
+		var notifiers *[]string
+		if settings.DeviceNotifiers != nil {
+			ids := []string{}
+			for _, notifier := range *settings.DeviceNotifiers {
+				if notifier.Id != nil {
+					ids = append(ids, *notifier.Id)
+				}
+			}
+			notifiers = &ids
+		}
+		target.CIBASettings = &DomainCIBASettings{
+			Enabled:              convert.Bool(settings.Enabled),
+			AuthReqExpiry:        convert.Int32(settings.AuthReqExpiry),
+			TokenReqInterval:     convert.Int32(settings.TokenReqInterval),
+			BindingMessageLength: convert.Int32(settings.BindingMessageLength),
+			DeviceNotifiers:      convert.StringList(notifiers),
+		}
+	}
+	return target
+}
+
+func mapDomainLoginSettings(source *client.LoginSettings) *DomainLoginSettings {
+	if source == nil {
+		return nil
+	}
+	return &DomainLoginSettings{
+		Inherited:                          convert.Bool(source.Inherited),
+		ForgotPasswordEnabled:              convert.Bool(source.ForgotPasswordEnabled),
+		RegisterEnabled:                    convert.Bool(source.RegisterEnabled),
+		RememberMeEnabled:                  convert.Bool(source.RememberMeEnabled),
+		PasswordlessEnabled:                convert.Bool(source.PasswordlessEnabled),
+		PasswordlessRememberDeviceEnabled:  convert.Bool(source.PasswordlessRememberDeviceEnabled),
+		PasswordlessEnforcePasswordEnabled: convert.Bool(source.PasswordlessEnforcePasswordEnabled),
+		PasswordlessEnforcePasswordMaxAge:  convert.Int32(source.PasswordlessEnforcePasswordMaxAge),
+		EnforcePasswordPolicyEnabled:       convert.Bool(source.EnforcePasswordPolicyEnabled),
+		HideForm:                           convert.Bool(source.HideForm),
+		IdentifierFirstEnabled:             convert.Bool(source.IdentifierFirstEnabled),
+	}
+}
+
 func GetDomainDataSourceSchema() *schema.Schema {
 	return &schema.Schema{
 		MarkdownDescription: "Domain data source",
@@ -108,106 +187,127 @@ func GetDomainDataSourceSchema() *schema.Schema {
 				MarkdownDescription: "Domain vhost_mode",
 				Computed:            true,
 			},
-			"oidc": schema.SetNestedAttribute{
-				NestedObject: schema.NestedAttributeObject{
-					Attributes: map[string]schema.Attribute{
-						"client_registration_settings": schema.SetNestedAttribute{
-							NestedObject: schema.NestedAttributeObject{
-								Attributes: map[string]schema.Attribute{
-									"allow_localhost_redirect_uri": schema.BoolAttribute{
-										Computed: true,
-									},
-									"allow_http_scheme_redirect_uri": schema.BoolAttribute{
-										Computed: true,
-									},
-									"allow_wild_card_redirect_uri": schema.BoolAttribute{
-										Computed: true,
-									},
-									"is_dynamic_client_registration_enabled": schema.BoolAttribute{
-										Computed: true,
-									},
-									"is_allowed_scopes_enabled": schema.BoolAttribute{
-										Computed: true,
-									},
-									"is_client_template_enabled": schema.BoolAttribute{
-										Computed: true,
-									},
-								},
-							},
-							Computed: true,
-						},
-						"security_profile_settings": schema.SetNestedAttribute{
-							NestedObject: schema.NestedAttributeObject{
-								Attributes: map[string]schema.Attribute{
-									"enable_plain_fapi": schema.BoolAttribute{
-										Computed: true,
-									},
-									"enable_fapi_brazil": schema.BoolAttribute{
-										Computed: true,
-									},
-								},
-							},
-							Computed: true,
+			"oidc": schema.SingleNestedAttribute{
+				MarkdownDescription: "Domain OpenID Connect settings",
+				Attributes: map[string]schema.Attribute{
+					"client_registration_settings": schema.SingleNestedAttribute{
+						MarkdownDescription: "Dynamic client registration settings",
+						Attributes: map[string]schema.Attribute{
+							"allow_localhost_redirect_uri": schema.BoolAttribute{
+								Computed: true,
+							},
+							"allow_http_scheme_redirect_uri": schema.BoolAttribute{
+								Computed: true,
+							},
+							"allow_wild_card_redirect_uri": schema.BoolAttribute{
+								Computed: true,
+							},
+							"is_dynamic_client_registration_enabled": schema.BoolAttribute{
+								Computed: true,
+							},
+							"is_open_dynamic_client_registration_enabled": schema.BoolAttribute{
+								Computed: true,
+							},
+							"is_allowed_scopes_enabled": schema.BoolAttribute{
+								Computed: true,
+							},
+							"is_client_template_enabled": schema.BoolAttribute{
+								Computed: true,
+							},
+							"allowed_scopes": schema.ListAttribute{
+								ElementType: types.StringType,
+								Computed:    true,
+							},
+							"default_scopes": schema.ListAttribute{
+								ElementType: types.StringType,
+								Computed:    true,
+							},
 						},
-						"redirect_uri_strict_matching": schema.BoolAttribute{
-							Computed: true,
+						Computed: true,
+					},
+					"security_profile_settings": schema.SingleNestedAttribute{
+						MarkdownDescription: "FAPI security profile settings",
+						Attributes: map[string]schema.Attribute{
+							"enable_plain_fapi": schema.BoolAttribute{
+								Computed: true,
+							},
+							"enable_fapi_brazil": schema.BoolAttribute{
+								Computed: true,
+							},
 						},
-						"ciba_settings": schema.SetNestedAttribute{
-							NestedObject: schema.NestedAttributeObject{
-								Attributes: map[string]schema.Attribute{
-									"enabled": schema.BoolAttribute{
-										Computed: true,
-									},
-									"auth_req_expiry": schema.NumberAttribute{
-										Computed: true,
-									},
-									"token_req_interval": schema.NumberAttribute{
-										Computed: true,
-									},
-									"binding_message_length": schema.NumberAttribute{
-										Computed: true,
-									},
-								},
-							},
-							Computed: true,
+						Computed: true,
+					},
+					"redirect_uri_strict_matching": schema.BoolAttribute{
+						Computed: true,
+					},
+					"post_logout_redirect_uris": schema.ListAttribute{
+						ElementType: types.StringType,
+						Computed:    true,
+					},
+					"request_uris": schema.ListAttribute{
+						ElementType: types.StringType,
+						Computed:    true,
+					},
+					"ciba_settings": schema.SingleNestedAttribute{
+						MarkdownDescription: "Client Initiated Backchannel Authentication settings",
+						Attributes: map[string]schema.Attribute{
+							"enabled": schema.BoolAttribute{
+								Computed: true,
+							},
+							"auth_req_expiry": schema.Int64Attribute{
+								Computed: true,
+							},
+							"token_req_interval": schema.Int64Attribute{
+								Computed: true,
+							},
+							"binding_message_length": schema.Int64Attribute{
+								Computed: true,
+							},
+							"device_notifiers": schema.ListAttribute{
+								ElementType: types.StringType,
+								Computed:    true,
+							},
 						},
+						Computed: true,
 					},
 				},
 				Computed: true,
 			},
-			"login_settings": schema.SetNestedAttribute{
-				NestedObject: schema.NestedAttributeObject{
-					Attributes: map[string]schema.Attribute{
-						"inherited": schema.BoolAttribute{
-							Computed: true,
-						},
-						"forgot_password_enabled": schema.BoolAttribute{
-							Computed: true,
-						},
-						"register_enabled": schema.BoolAttribute{
-							Computed: true,
-						},
-						"remember_me_enabled": schema.BoolAttribute{
-							Computed: true,
-						},
-						"passwordless_enabled": schema.BoolAttribute{
-							Computed: true,
-						},
-						"passwordless_remember_device_enabled": schema.BoolAttribute{
-							Computed: true,
-						},
-						"passwordless_enforce_password_enabled": schema.BoolAttribute{
-							Computed: true,
-						},
-						"passwordless_device_naming_enabled": schema.BoolAttribute{
-							Computed: true,
-						},
-						"hide_form": schema.BoolAttribute{
-							Computed: true,
-						},
-						"identifier_first_enabled": schema.BoolAttribute{
-							Computed: true,
-						},
+			"login_settings": schema.SingleNestedAttribute{
+				MarkdownDescription: "Domain login settings",
+				Attributes: map[string]schema.Attribute{
+					"inherited": schema.BoolAttribute{
+						Computed: true,
+					},
+					"forgot_password_enabled": schema.BoolAttribute{
+						Computed: true,
+					},
+					"register_enabled": schema.BoolAttribute{
+						Computed: true,
+					},
+					"remember_me_enabled": schema.BoolAttribute{
+						Computed: true,
+					},
+					"passwordless_enabled": schema.BoolAttribute{
+						Computed: true,
+					},
+					"passwordless_remember_device_enabled": schema.BoolAttribute{
+						Computed: true,
+					},
+					"passwordless_enforce_password_enabled": schema.BoolAttribute{
+						Computed: true,
+					},
+					"passwordless_enforce_password_max_age": schema.Int64Attribute{
+						Computed: true,
+					},
+					"enforce_password_policy_enabled": schema.BoolAttribute{
+						Computed: true,
+					},
+					"hide_form": schema.BoolAttribute{
+						Computed: true,
+					},
+					"identifier_first_enabled": schema.BoolAttribute{
+						Computed: true,
 					},
 				},
 				Computed: true,
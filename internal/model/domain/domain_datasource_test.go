@@ -0,0 +1,43 @@
+package domain
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/thornleyk/graviteeioam-service/client"
+)
+
+func TestMapDomainDataSourceSparsePayload(t *testing.T) {
+	var source client.Domain
+	if err := json.Unmarshal([]byte(`{"id":"test","oidc":{"cibaSettings":{}},"loginSettings":{}}`), &source); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	target, err := MapDomainDataSource(&source, DomainDataSourceModel{DomainId: types.StringValue("DEFAULT:DEFAULT:test")})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if !target.Hrid.IsNull() || !target.Name.IsNull() || !target.Description.IsNull() || !target.Enabled.IsNull() || !target.Master.IsNull() || !target.VHostMode.IsNull() {
+		t.Errorf("expected missing fields to be null, got %+v", target)
+	}
+	if target.DomainOIDC == nil || target.DomainOIDC.ClientRegistrationSettings != nil || !target.DomainOIDC.PostLogoutRedirectURIs.IsNull() {
+		t.Errorf("unexpected oidc settings: %+v", target.DomainOIDC)
+	}
+	if ciba := target.DomainOIDC.CIBASettings; ciba == nil || !ciba.AuthReqExpiry.IsNull() || !ciba.DeviceNotifiers.IsNull() {
+		t.Errorf("unexpected ciba settings: %+v", ciba)
+	}
+	if target.DomainLoginSettings == nil || !target.DomainLoginSettings.PasswordlessEnforcePasswordMaxAge.IsNull() {
+		t.Errorf("unexpected login settings: %+v", target.DomainLoginSettings)
+	}
+}
+
+func TestMapDomainDataSourceEmptyPayload(t *testing.T) {
+	target, err := MapDomainDataSource(&client.Domain{}, DomainDataSourceModel{})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if target.DomainOIDC != nil || target.DomainLoginSettings != nil {
+		t.Errorf("expected missing settings to be null, got %+v", target)
+	}
+}
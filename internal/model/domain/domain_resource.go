@@ -1,17 +1,80 @@
 package domain
 
 import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
-	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/thornleyk/graviteeioam-service/client"
+	"github.com/thornleyk/terraform-provider-graviteeioam/internal/model/convert"
 )
 
 type DomainResourceModel struct {
-	ConfigurableAttribute types.String `tfsdk:"configurable_attribute"`
-	Defaulted             types.String `tfsdk:"defaulted"`
-	Id                    types.String `tfsdk:"id"`
+	Id             types.String `tfsdk:"id"`
+	OrganizationId types.String `tfsdk:"organization_id"`
+	EnvironmentId  types.String `tfsdk:"environment_id"`
+	DomainId       types.String `tfsdk:"domain_id"`
+	Hrid           types.String `tfsdk:"hrid"`
+	Name           types.String `tfsdk:"name"`
+	Description    types.String `tfsdk:"description"`
+	Enabled        types.Bool   `tfsdk:"enabled"`
+	VHostMode      types.Bool   `tfsdk:"vhost_mode"`
+	Path           types.String `tfsdk:"path"`
+	Tags           types.Set    `tfsdk:"tags"`
+}
+
+func NewDomainFromResource(source DomainResourceModel) client.NewDomain {
+	return client.NewDomain{
+		Name:        source.Name.ValueString(),
+		Description: source.Description.ValueStringPointer(),
+	}
+}
+
+func PatchDomainFromResource(ctx context.Context, source DomainResourceModel) (client.PatchDomain, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	// An empty description clears it, the patch leaves nil fields untouched.
+	description := source.Description.ValueString()
+	target := client.PatchDomain{
+		Name:        source.Name.ValueStringPointer(),
+		Description: &description,
+		Enabled:     source.Enabled.ValueBoolPointer(),
+		VhostMode:   source.VHostMode.ValueBoolPointer(),
+	}
+	if !source.Path.IsNull() && !source.Path.IsUnknown() {
+		target.Path = source.Path.ValueStringPointer()
+	}
+	if !source.Tags.IsNull() && !source.Tags.IsUnknown() {
+		tags := []string{}
+		diags.Append(source.Tags.ElementsAs(ctx, &tags, false)...)
+		target.Tags = &tags
+	}
+	return target, diags
+}
+
+func MapDomainResource(ctx context.Context, source *client.Domain, target DomainResourceModel) (DomainResourceModel, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	target.DomainId = convert.String(source.Id)
+	target.Id = types.StringValue(target.OrganizationId.ValueString() + ":" + target.EnvironmentId.ValueString() + ":" + target.DomainId.ValueString())
+	target.Hrid = convert.String(source.Hrid)
+	target.Name = convert.String(source.Name)
+	if (source.Description != nil && *source.Description != "") || !target.Description.IsNull() {
+		target.Description = convert.String(source.Description)
+	}
+	target.Enabled = types.BoolValue(source.Enabled != nil && *source.Enabled)
+	target.VHostMode = types.BoolValue(source.VhostMode != nil && *source.VhostMode)
+	target.Path = convert.String(source.Path)
+	if source.Tags != nil && len(*source.Tags) > 0 {
+		tags, tagDiags := types.SetValueFrom(ctx, types.StringType, *source.Tags)
+		diags.Append(tagDiags...)
+		target.Tags = tags
+	} else if !target.Tags.IsNull() {
+		target.Tags = types.SetValueMust(types.StringType, nil)
+	}
+	return target, diags
 }
 
 func GetDomainResourceSchema() *schema.Schema {
@@ -19,23 +82,71 @@ func GetDomainResourceSchema() *schema.Schema {
 		MarkdownDescription: "Domain resource",
 
 		Attributes: map[string]schema.Attribute{
-			"configurable_attribute": schema.StringAttribute{
-				MarkdownDescription: "Example configurable attribute",
+			"id": schema.StringAttribute{
+				MarkdownDescription: "TF identifier in the form organizationId:environmentId:domainId",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"organization_id": schema.StringAttribute{
+				MarkdownDescription: "Organization id",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"environment_id": schema.StringAttribute{
+				MarkdownDescription: "Environment id",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"domain_id": schema.StringAttribute{
+				MarkdownDescription: "Domain id",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"hrid": schema.StringAttribute{
+				MarkdownDescription: "Domain hrid",
+				Computed:            true,
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Domain name",
+				Required:            true,
+			},
+			"description": schema.StringAttribute{
+				MarkdownDescription: "Domain description",
 				Optional:            true,
 			},
-			"defaulted": schema.StringAttribute{
-				MarkdownDescription: "Example configurable attribute with default value",
+			"enabled": schema.BoolAttribute{
+				MarkdownDescription: "Domain enabled",
 				Optional:            true,
 				Computed:            true,
-				Default:             stringdefault.StaticString("example value when not configured"),
+				Default:             booldefault.StaticBool(false),
 			},
-			"id": schema.StringAttribute{
+			"vhost_mode": schema.BoolAttribute{
+				MarkdownDescription: "Domain vhost_mode",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"path": schema.StringAttribute{
+				MarkdownDescription: "Domain context path, derived from the name when not set",
+				Optional:            true,
 				Computed:            true,
-				MarkdownDescription: "Example identifier",
 				PlanModifiers: []planmodifier.String{
 					stringplanmodifier.UseStateForUnknown(),
 				},
 			},
+			"tags": schema.SetAttribute{
+				MarkdownDescription: "Domain sharding tags",
+				ElementType:         types.StringType,
+				Optional:            true,
+			},
 		},
 	}
 }
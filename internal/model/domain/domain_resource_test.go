@@ -0,0 +1,51 @@
+package domain
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/thornleyk/graviteeioam-service/client"
+)
+
+func TestMapDomainResourceSparsePayload(t *testing.T) {
+	var source client.Domain
+	if err := json.Unmarshal([]byte(`{"id":"test"}`), &source); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	target, diags := MapDomainResource(context.Background(), &source, DomainResourceModel{
+		OrganizationId: types.StringValue("DEFAULT"),
+		EnvironmentId:  types.StringValue("DEFAULT"),
+		Description:    types.StringNull(),
+		Tags:           types.SetNull(types.StringType),
+	})
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+	if target.Id.ValueString() != "DEFAULT:DEFAULT:test" {
+		t.Errorf("unexpected id: %s", target.Id)
+	}
+	if !target.Name.IsNull() || !target.Description.IsNull() || !target.Path.IsNull() || !target.Tags.IsNull() {
+		t.Errorf("expected missing fields to be null, got %+v", target)
+	}
+	if target.Enabled.ValueBool() || target.VHostMode.ValueBool() {
+		t.Errorf("expected missing flags to default to false, got %+v", target)
+	}
+}
+
+func TestPatchDomainFromResourceClearsDescription(t *testing.T) {
+	patch, diags := PatchDomainFromResource(context.Background(), DomainResourceModel{
+		Name:        types.StringValue("test"),
+		Description: types.StringNull(),
+		Path:        types.StringUnknown(),
+		Tags:        types.SetNull(types.StringType),
+	})
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+	if patch.Description == nil || *patch.Description != "" {
+		t.Errorf("expected an unset description to be cleared, got %v", patch.Description)
+	}
+}
@@ -4,15 +4,61 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/thornleyk/graviteeioam-service/client"
+	"github.com/thornleyk/terraform-provider-graviteeioam/internal/model/convert"
 )
 
+type DomainIdentityUserMapper struct {
+	Mapping types.String `tfsdk:"mapping"`
+}
+
+type DomainIdentityRoleMapper struct {
+	Mapping types.List `tfsdk:"mapping"`
+}
+
 type DomainIdentityDataSourceModel struct {
-	Id       types.String `tfsdk:"id"`
-	DomainId types.String `tfsdk:"domain_id"`
+	Id                 types.String                        `tfsdk:"id"`
+	OrganizationId     types.String                        `tfsdk:"organization_id"`
+	EnvironmentId      types.String                        `tfsdk:"environment_id"`
+	DomainId           types.String                        `tfsdk:"domain_id"`
+	IdentityProviderId types.String                        `tfsdk:"identity_provider_id"`
+	Name               types.String                        `tfsdk:"name"`
+	Type               types.String                        `tfsdk:"type"`
+	Configuration      types.String                        `tfsdk:"configuration"`
+	UserMappers        map[string]DomainIdentityUserMapper `tfsdk:"user_mappers"`
+	RoleMappers        map[string]DomainIdentityRoleMapper `tfsdk:"role_mappers"`
+	ReferenceType      types.String                        `tfsdk:"reference_type"`
+	ReferenceId        types.String                        `tfsdk:"reference_id"`
+	External           types.Bool                          `tfsdk:"external"`
+	Whitelist          types.List                          `tfsdk:"whitelist"`
 }
 
 func MapDomainIdentityDataSource(source *client.IdentityProvider, target DomainIdentityDataSourceModel) (DomainIdentityDataSourceModel, error) {
-
+	target.Id = types.StringValue(target.OrganizationId.ValueString() + ":" + target.EnvironmentId.ValueString() + ":" + target.DomainId.ValueString() + ":" + target.IdentityProviderId.ValueString())
+	target.Name = convert.String(source.Name)
+	target.Type = convert.String(source.Type)
+	target.Configuration = convert.NormalizedJSON(source.Configuration)
+	target.UserMappers = nil
+	if source.Mappers != nil {
+		target.UserMappers = map[string]DomainIdentityUserMapper{}
+		for attribute, mapping := range *source.Mappers {
+			target.UserMappers[attribute] = DomainIdentityUserMapper{Mapping: types.StringValue(mapping)}
+		}
+	}
+	target.RoleMappers = nil
+	if source.RoleMapper != nil {
+		target.RoleMappers = map[string]DomainIdentityRoleMapper{}
+		for role, rules := range *source.RoleMapper {
+			rules := rules
+			target.RoleMappers[role] = DomainIdentityRoleMapper{Mapping: convert.StringList(&rules)}
+		}
+	}
+	target.ReferenceType = types.StringNull()
+	if source.ReferenceType != nil {
+		target.ReferenceType = types.StringValue(string(*source.ReferenceType))
+	}
+	target.ReferenceId = convert.String(source.ReferenceId)
+	target.External = convert.Bool(source.External)
+	target.Whitelist = convert.StringList(source.DomainWhitelist)
 	return target, nil
 }
 
@@ -21,16 +67,24 @@ func GetDomainIdentityDataSourceSchema() *schema.Schema {
 		MarkdownDescription: "DomainIdentity data source",
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{
-				MarkdownDescription: "TF identifier",
+				MarkdownDescription: "TF identifier in the form organizationId:environmentId:domainId:identityProviderId",
 				Computed:            true,
 			},
+			"organization_id": schema.StringAttribute{
+				MarkdownDescription: "Organization id",
+				Required:            true,
+			},
+			"environment_id": schema.StringAttribute{
+				MarkdownDescription: "Environment id",
+				Required:            true,
+			},
 			"domain_id": schema.StringAttribute{
 				MarkdownDescription: "Domain id",
 				Required:            true,
 			},
-			"hrid": schema.StringAttribute{
-				MarkdownDescription: "Domain hrid",
-				Computed:            true,
+			"identity_provider_id": schema.StringAttribute{
+				MarkdownDescription: "Domain Identity id",
+				Required:            true,
 			},
 			"name": schema.StringAttribute{
 				MarkdownDescription: "Domain Identity name",
@@ -41,8 +95,9 @@ func GetDomainIdentityDataSourceSchema() *schema.Schema {
 				Computed:            true,
 			},
 			"configuration": schema.StringAttribute{
-				MarkdownDescription: "Domain Identity configuration",
+				MarkdownDescription: "Domain Identity configuration, a normalized JSON document",
 				Computed:            true,
+				Sensitive:           true,
 			},
 			"user_mappers": schema.MapNestedAttribute{
 				MarkdownDescription: "Domain Identity user mapping",
@@ -0,0 +1,67 @@
+package domain_identity
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/thornleyk/graviteeioam-service/client"
+)
+
+func TestMapDomainIdentityDataSourceMinimalPayload(t *testing.T) {
+	var source client.IdentityProvider
+	if err := json.Unmarshal([]byte(`{"id":"default-idp"}`), &source); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	target, err := MapDomainIdentityDataSource(&source, DomainIdentityDataSourceModel{OrganizationId: types.StringValue("DEFAULT"), EnvironmentId: types.StringValue("DEFAULT"), DomainId: types.StringValue("domain"), IdentityProviderId: types.StringValue("default-idp")})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if target.Id.ValueString() != "DEFAULT:DEFAULT:domain:default-idp" || !target.Name.IsNull() || !target.ReferenceType.IsNull() {
+		t.Errorf("expected missing fields to be null, got %+v", target)
+	}
+	if target.UserMappers != nil || target.RoleMappers != nil || !target.Whitelist.IsNull() {
+		t.Errorf("expected missing collections to be null, got %+v", target)
+	}
+}
+
+func TestMapDomainIdentityDataSource(t *testing.T) {
+	var source client.IdentityProvider
+	payload := `{
+		"id": "default-idp",
+		"name": "Default Identity Provider",
+		"type": "mongo-am-idp",
+		"configuration": "{\"b\": 1, \"a\": \"x\"}",
+		"mappers": {"email": "mail"},
+		"roleMapper": {"admin": ["groups=admins"]},
+		"referenceType": "DOMAIN",
+		"referenceId": "domain",
+		"external": false,
+		"domainWhitelist": ["example.com"]
+	}`
+	if err := json.Unmarshal([]byte(payload), &source); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	target, err := MapDomainIdentityDataSource(&source, DomainIdentityDataSourceModel{OrganizationId: types.StringValue("DEFAULT"), EnvironmentId: types.StringValue("DEFAULT"), DomainId: types.StringValue("domain"), IdentityProviderId: types.StringValue("default-idp")})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if target.Name.ValueString() != "Default Identity Provider" || target.Type.ValueString() != "mongo-am-idp" || target.External.ValueBool() {
+		t.Errorf("unexpected identity provider: %+v", target)
+	}
+	if target.ReferenceType.ValueString() != "DOMAIN" || target.ReferenceId.ValueString() != "domain" || target.Configuration.ValueString() != `{"a":"x","b":1}` {
+		t.Errorf("unexpected reference: %+v", target)
+	}
+	if target.UserMappers["email"].Mapping.ValueString() != "mail" {
+		t.Errorf("unexpected user mappers: %v", target.UserMappers)
+	}
+	if !target.RoleMappers["admin"].Mapping.Equal(types.ListValueMust(types.StringType, []attr.Value{types.StringValue("groups=admins")})) {
+		t.Errorf("unexpected role mappers: %v", target.RoleMappers)
+	}
+	if !target.Whitelist.Equal(types.ListValueMust(types.StringType, []attr.Value{types.StringValue("example.com")})) {
+		t.Errorf("unexpected whitelist: %v", target.Whitelist)
+	}
+}
@@ -1,16 +1,188 @@
 package domain_identity
 
 import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/thornleyk/graviteeioam-service/client"
+	"github.com/thornleyk/terraform-provider-graviteeioam/internal/model/convert"
 )
 
 type DomainIdentityResourceModel struct {
-	Id types.String `tfsdk:"id"`
+	Id                 types.String `tfsdk:"id"`
+	OrganizationId     types.String `tfsdk:"organization_id"`
+	EnvironmentId      types.String `tfsdk:"environment_id"`
+	DomainId           types.String `tfsdk:"domain_id"`
+	IdentityProviderId types.String `tfsdk:"identity_provider_id"`
+	Type               types.String `tfsdk:"type"`
+	Name               types.String `tfsdk:"name"`
+	Configuration      types.String `tfsdk:"configuration"`
+	UserMappers        types.Map    `tfsdk:"user_mappers"`
+	RoleMappers        types.Map    `tfsdk:"role_mappers"`
+	DomainWhitelist    types.List   `tfsdk:"domain_whitelist"`
+	External           types.Bool   `tfsdk:"external"`
+}
+
+func NewDomainIdentityFromResource(ctx context.Context, source DomainIdentityResourceModel) (client.NewIdentityProvider, diag.Diagnostics) {
+	domainWhitelist, diags := convert.OptionalStrings(ctx, source.DomainWhitelist)
+	return client.NewIdentityProvider{
+		Type:            source.Type.ValueString(),
+		Name:            source.Name.ValueString(),
+		Configuration:   source.Configuration.ValueString(),
+		DomainWhitelist: domainWhitelist,
+		External:        convert.OptionalBool(source.External),
+	}, diags
+}
+
+// UpdateDomainIdentityFromResource returns the full update of an identity
+// provider, mappers and whitelist removed from the configuration are cleared.
+func UpdateDomainIdentityFromResource(ctx context.Context, source DomainIdentityResourceModel) (client.UpdateIdentityProvider, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	target := client.UpdateIdentityProvider{
+		Name:            source.Name.ValueString(),
+		Configuration:   source.Configuration.ValueString(),
+		DomainWhitelist: &[]string{},
+		Mappers:         &map[string]string{},
+		RoleMapper:      &map[string][]string{},
+	}
+
+	domainWhitelist, valueDiags := convert.OptionalStrings(ctx, source.DomainWhitelist)
+	diags.Append(valueDiags...)
+	if domainWhitelist != nil {
+		target.DomainWhitelist = domainWhitelist
+	}
+	userMappers, valueDiags := convert.OptionalStringMap(ctx, source.UserMappers)
+	diags.Append(valueDiags...)
+	if userMappers != nil {
+		target.Mappers = userMappers
+	}
+	roleMappers, valueDiags := convert.OptionalStringListMap(ctx, source.RoleMappers)
+	diags.Append(valueDiags...)
+	if roleMappers != nil {
+		target.RoleMapper = roleMappers
+	}
+	return target, diags
+}
+
+func MapDomainIdentityResource(source *client.IdentityProvider, target DomainIdentityResourceModel) DomainIdentityResourceModel {
+	target.IdentityProviderId = convert.String(source.Id)
+	target.Id = types.StringValue(target.OrganizationId.ValueString() + ":" + target.EnvironmentId.ValueString() + ":" + target.DomainId.ValueString() + ":" + target.IdentityProviderId.ValueString())
+	target.Type = convert.String(source.Type)
+	target.Name = convert.String(source.Name)
+	target.Configuration = convert.JSON(convert.UnmaskedJSON(source.Configuration, target.Configuration), target.Configuration)
+	target.External = types.BoolValue(source.External != nil && *source.External)
+
+	// Empty collections are returned for unset mappers and whitelist, they
+	// are kept null unless configured.
+	if (source.Mappers != nil && len(*source.Mappers) > 0) || !target.UserMappers.IsNull() {
+		mappers := map[string]string{}
+		if source.Mappers != nil {
+			mappers = *source.Mappers
+		}
+		target.UserMappers = convert.StringMap(&mappers)
+	}
+	if (source.RoleMapper != nil && len(*source.RoleMapper) > 0) || !target.RoleMappers.IsNull() {
+		roleMappers := map[string][]string{}
+		if source.RoleMapper != nil {
+			roleMappers = *source.RoleMapper
+		}
+		target.RoleMappers = convert.StringListMap(&roleMappers)
+	}
+	if (source.DomainWhitelist != nil && len(*source.DomainWhitelist) > 0) || !target.DomainWhitelist.IsNull() {
+		domainWhitelist := []string{}
+		if source.DomainWhitelist != nil {
+			domainWhitelist = *source.DomainWhitelist
+		}
+		target.DomainWhitelist = convert.StringList(&domainWhitelist)
+	}
+	return target
 }
 
 func GetDomainIdentityResourceSchema() *schema.Schema {
 	return &schema.Schema{
-		MarkdownDescription: "DomainIdentity resource",
+		MarkdownDescription: "DomainIdentity resource, an identity provider of a security domain",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "TF identifier in the form organizationId:environmentId:domainId:identityProviderId",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"organization_id": schema.StringAttribute{
+				MarkdownDescription: "Organization id",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"environment_id": schema.StringAttribute{
+				MarkdownDescription: "Environment id",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"domain_id": schema.StringAttribute{
+				MarkdownDescription: "Domain id",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"identity_provider_id": schema.StringAttribute{
+				MarkdownDescription: "Domain Identity id",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"type": schema.StringAttribute{
+				MarkdownDescription: "Domain Identity type, the identity provider plugin id such as `mongo-am-idp`, `ldap-am-idp` or `oauth2-generic-am-idp`",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Domain Identity name",
+				Required:            true,
+			},
+			"configuration": schema.StringAttribute{
+				MarkdownDescription: "Domain Identity configuration, a JSON document matching the plugin schema",
+				Required:            true,
+				Sensitive:           true,
+			},
+			"user_mappers": schema.MapAttribute{
+				MarkdownDescription: "Domain Identity user mapping, from user profile attribute to identity provider attribute",
+				ElementType:         types.StringType,
+				Optional:            true,
+			},
+			"role_mappers": schema.MapAttribute{
+				MarkdownDescription: "Domain Identity role mapping, from role id to the user attribute rules granting it",
+				ElementType:         types.ListType{ElemType: types.StringType},
+				Optional:            true,
+			},
+			"domain_whitelist": schema.ListAttribute{
+				MarkdownDescription: "Domain Identity whitelist of user email domains",
+				ElementType:         types.StringType,
+				Optional:            true,
+			},
+			"external": schema.BoolAttribute{
+				MarkdownDescription: "Domain Identity exposed externally, for social and enterprise identity providers",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+					boolplanmodifier.RequiresReplace(),
+				},
+			},
+		},
 	}
 }
@@ -0,0 +1,78 @@
+package domain_identity
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/thornleyk/graviteeioam-service/client"
+)
+
+func testDomainIdentityResourceModel() DomainIdentityResourceModel {
+	return DomainIdentityResourceModel{
+		OrganizationId:  types.StringValue("DEFAULT"),
+		EnvironmentId:   types.StringValue("DEFAULT"),
+		DomainId:        types.StringValue("domain"),
+		Type:            types.StringValue("inline-am-idp"),
+		Name:            types.StringValue("inline"),
+		Configuration:   types.StringValue(`{"users": [], "passwordEncoder": "BCrypt"}`),
+		UserMappers:     types.MapValueMust(types.StringType, map[string]attr.Value{"email": types.StringValue("mail")}),
+		RoleMappers:     types.MapNull(types.ListType{ElemType: types.StringType}),
+		DomainWhitelist: types.ListNull(types.StringType),
+		External:        types.BoolUnknown(),
+	}
+}
+
+func TestUpdateDomainIdentityFromResourceClearsUnsetValues(t *testing.T) {
+	update, diags := UpdateDomainIdentityFromResource(context.Background(), testDomainIdentityResourceModel())
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+	if (*update.Mappers)["email"] != "mail" {
+		t.Errorf("unexpected user mappers: %v", update.Mappers)
+	}
+	if update.RoleMapper == nil || len(*update.RoleMapper) != 0 || update.DomainWhitelist == nil || len(*update.DomainWhitelist) != 0 {
+		t.Errorf("expected unset role mappers and whitelist to be cleared, got %+v", update)
+	}
+}
+
+func TestMapDomainIdentityResource(t *testing.T) {
+	var source client.IdentityProvider
+	payload := `{
+		"id": "idp",
+		"type": "inline-am-idp",
+		"name": "inline",
+		"configuration": "{\"passwordEncoder\":\"BCrypt\",\"users\":[]}",
+		"mappers": {"email": "mail"},
+		"roleMapper": {},
+		"domainWhitelist": []
+	}`
+	if err := json.Unmarshal([]byte(payload), &source); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	data := testDomainIdentityResourceModel()
+	target := MapDomainIdentityResource(&source, data)
+	if target.Id.ValueString() != "DEFAULT:DEFAULT:domain:idp" || target.External.ValueBool() {
+		t.Errorf("unexpected identity provider: %+v", target)
+	}
+	if target.Configuration != data.Configuration {
+		t.Errorf("expected the configured JSON to be kept, got %s", target.Configuration)
+	}
+	if !target.RoleMappers.IsNull() || !target.DomainWhitelist.IsNull() || !target.UserMappers.Equal(data.UserMappers) {
+		t.Errorf("unexpected mappers: %+v", target)
+	}
+}
+
+func TestMapDomainIdentityResourceUnmasksConfiguration(t *testing.T) {
+	configuration := `{"clientId":"client","clientSecret":"********"}`
+	data := testDomainIdentityResourceModel()
+	data.Configuration = types.StringValue(`{"clientId": "client", "clientSecret": "secret"}`)
+
+	target := MapDomainIdentityResource(&client.IdentityProvider{Configuration: &configuration}, data)
+	if target.Configuration != data.Configuration {
+		t.Errorf("expected the masked secret to keep the configured value, got %s", target.Configuration)
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/thornleyk/graviteeioam-service/client"
+	"github.com/thornleyk/terraform-provider-graviteeioam/internal/model/convert"
 )
 
 type DomainLightDataSourceModel struct {
@@ -20,7 +21,7 @@ func MapEnvironmentDataSource(source []client.Domain, target EnvironmentDataSour
 	target.Id = target.EnvironmentId
 	for _, domain := range source {
 		var domainData = DomainLightDataSourceModel{
-			Hrid: types.StringValue(*domain.Hrid),
+			Hrid: convert.String(domain.Hrid),
 		}
 		target.Domains = append(target.Domains, domainData)
 	}
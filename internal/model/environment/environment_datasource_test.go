@@ -0,0 +1,24 @@
+package environment
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/thornleyk/graviteeioam-service/client"
+)
+
+func TestMapEnvironmentDataSourceSparsePayload(t *testing.T) {
+	var source []client.Domain
+	if err := json.Unmarshal([]byte(`[{"id":"a","hrid":"first"},{"id":"b"}]`), &source); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	target, err := MapEnvironmentDataSource(source, EnvironmentDataSourceModel{EnvironmentId: types.StringValue("DEFAULT:DEFAULT")})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if len(target.Domains) != 2 || target.Domains[0].Hrid.ValueString() != "first" || !target.Domains[1].Hrid.IsNull() {
+		t.Errorf("unexpected domains: %+v", target.Domains)
+	}
+}
@@ -0,0 +1,128 @@
+package factor
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/thornleyk/graviteeioam-service/client"
+	"github.com/thornleyk/terraform-provider-graviteeioam/internal/model/convert"
+)
+
+// FactorTypes lists the kinds of factors.
+var FactorTypes = []string{"OTP", "SMS", "EMAIL", "CALL", "FIDO2", "RECOVERY_CODE", "HTTP"}
+
+type FactorResourceModel struct {
+	Id             types.String `tfsdk:"id"`
+	OrganizationId types.String `tfsdk:"organization_id"`
+	EnvironmentId  types.String `tfsdk:"environment_id"`
+	DomainId       types.String `tfsdk:"domain_id"`
+	FactorId       types.String `tfsdk:"factor_id"`
+	Name           types.String `tfsdk:"name"`
+	Type           types.String `tfsdk:"type"`
+	FactorType     types.String `tfsdk:"factor_type"`
+	Configuration  types.String `tfsdk:"configuration"`
+}
+
+func NewFactorFromResource(source FactorResourceModel) client.NewFactor {
+	return client.NewFactor{
+		Name:          source.Name.ValueString(),
+		Type:          source.Type.ValueString(),
+		FactorType:    source.FactorType.ValueString(),
+		Configuration: source.Configuration.ValueString(),
+	}
+}
+
+func UpdateFactorFromResource(source FactorResourceModel) client.UpdateFactor {
+	return client.UpdateFactor{
+		Name:          source.Name.ValueString(),
+		Configuration: source.Configuration.ValueString(),
+	}
+}
+
+func MapFactorResource(source *client.Factor, target FactorResourceModel) FactorResourceModel {
+	target.FactorId = convert.String(source.Id)
+	target.Id = types.StringValue(target.OrganizationId.ValueString() + ":" + target.EnvironmentId.ValueString() + ":" + target.DomainId.ValueString() + ":" + target.FactorId.ValueString())
+	target.Name = convert.String(source.Name)
+	target.Type = convert.String(source.Type)
+	if source.FactorType != nil {
+		target.FactorType = types.StringValue(string(*source.FactorType))
+	} else {
+		target.FactorType = types.StringNull()
+	}
+	// AM masks the sensitive fields of the configuration, such as API keys.
+	target.Configuration = convert.JSON(convert.UnmaskedJSON(source.Configuration, target.Configuration), target.Configuration)
+	return target
+}
+
+func GetFactorResourceSchema() *schema.Schema {
+	return &schema.Schema{
+		MarkdownDescription: "Factor resource, a multi-factor authentication method of a security domain",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "TF identifier in the form organizationId:environmentId:domainId:factorId",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"organization_id": schema.StringAttribute{
+				MarkdownDescription: "Organization id",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"environment_id": schema.StringAttribute{
+				MarkdownDescription: "Environment id",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"domain_id": schema.StringAttribute{
+				MarkdownDescription: "Domain id",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"factor_id": schema.StringAttribute{
+				MarkdownDescription: "Factor id",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Factor name",
+				Required:            true,
+			},
+			"type": schema.StringAttribute{
+				MarkdownDescription: "Factor type, the factor plugin id such as `otp-am-factor`, `sms-am-factor`, `email-am-factor` or `fido2-am-factor`",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"factor_type": schema.StringAttribute{
+				MarkdownDescription: "Factor kind, one of `OTP`, `SMS`, `EMAIL`, `CALL`, `FIDO2`, `RECOVERY_CODE` or `HTTP`",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.OneOf(FactorTypes...),
+				},
+			},
+			"configuration": schema.StringAttribute{
+				MarkdownDescription: "Factor configuration, a JSON document matching the plugin schema. The sensitive fields masked by AM are compared with the configured values",
+				Required:            true,
+				Sensitive:           true,
+			},
+		},
+	}
+}
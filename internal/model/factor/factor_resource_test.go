@@ -0,0 +1,51 @@
+package factor
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/thornleyk/graviteeioam-service/client"
+)
+
+func testFactorResourceModel() FactorResourceModel {
+	return FactorResourceModel{
+		OrganizationId: types.StringValue("DEFAULT"),
+		EnvironmentId:  types.StringValue("DEFAULT"),
+		DomainId:       types.StringValue("domain"),
+		Name:           types.StringValue("SMS"),
+		Type:           types.StringValue("sms-am-factor"),
+		FactorType:     types.StringValue("SMS"),
+		Configuration:  types.StringValue(`{"countryCodes": "fr", "graviteeResource": "twilio", "apiKey": "secret"}`),
+	}
+}
+
+func TestMapFactorResourceUnmasksConfiguration(t *testing.T) {
+	var source client.Factor
+	payload := `{
+		"id": "factor",
+		"name": "SMS",
+		"type": "sms-am-factor",
+		"factorType": "SMS",
+		"configuration": "{\"countryCodes\":\"fr\",\"graviteeResource\":\"twilio\",\"apiKey\":\"********\"}"
+	}`
+	if err := json.Unmarshal([]byte(payload), &source); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	data := testFactorResourceModel()
+	target := MapFactorResource(&source, data)
+	if target.Id.ValueString() != "DEFAULT:DEFAULT:domain:factor" || target.FactorType.ValueString() != "SMS" {
+		t.Errorf("unexpected factor: %+v", target)
+	}
+	if target.Configuration != data.Configuration {
+		t.Errorf("expected the masked configuration to match the prior one, got %s", target.Configuration)
+	}
+
+	changed := `{"countryCodes":"us","graviteeResource":"twilio","apiKey":"********"}`
+	source.Configuration = &changed
+	target = MapFactorResource(&source, data)
+	if target.Configuration == data.Configuration {
+		t.Error("expected a changed configuration to show as a diff")
+	}
+}
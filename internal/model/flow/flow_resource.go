@@ -0,0 +1,259 @@
+package flow
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/thornleyk/graviteeioam-service/client"
+	"github.com/thornleyk/terraform-provider-graviteeioam/internal/model/convert"
+)
+
+type FlowStep struct {
+	Policy        types.String `tfsdk:"policy"`
+	Name          types.String `tfsdk:"name"`
+	Description   types.String `tfsdk:"description"`
+	Enabled       types.Bool   `tfsdk:"enabled"`
+	Condition     types.String `tfsdk:"condition"`
+	Configuration types.String `tfsdk:"configuration"`
+}
+
+type Flow struct {
+	FlowId    types.String `tfsdk:"flow_id"`
+	Name      types.String `tfsdk:"name"`
+	Type      types.String `tfsdk:"type"`
+	Enabled   types.Bool   `tfsdk:"enabled"`
+	Condition types.String `tfsdk:"condition"`
+	Pre       []FlowStep   `tfsdk:"pre"`
+	Post      []FlowStep   `tfsdk:"post"`
+}
+
+type FlowResourceModel struct {
+	Id             types.String `tfsdk:"id"`
+	OrganizationId types.String `tfsdk:"organization_id"`
+	EnvironmentId  types.String `tfsdk:"environment_id"`
+	DomainId       types.String `tfsdk:"domain_id"`
+	ApplicationId  types.String `tfsdk:"application_id"`
+	Flows          []Flow       `tfsdk:"flows"`
+}
+
+func newSteps(source []FlowStep) *[]client.Step {
+	steps := []client.Step{}
+	for _, step := range source {
+		steps = append(steps, client.Step{
+			Policy:        convert.OptionalString(step.Policy),
+			Name:          convert.OptionalString(step.Name),
+			Description:   convert.OptionalString(step.Description),
+			Enabled:       convert.OptionalBool(step.Enabled),
+			Condition:     convert.OptionalString(step.Condition),
+			Configuration: convert.OptionalString(step.Configuration),
+		})
+	}
+	return &steps
+}
+
+// NewFlowsFromResource returns the ordered flows of a domain or an
+// application. Flows are matched on their id, the flows without one are
+// created and the flows left out are deleted.
+func NewFlowsFromResource(source FlowResourceModel) []client.Flow {
+	flows := []client.Flow{}
+	for _, flow := range source.Flows {
+		flows = append(flows, client.Flow{
+			Id:        convert.OptionalString(flow.FlowId),
+			Name:      flow.Name.ValueString(),
+			Type:      client.FlowType(flow.Type.ValueString()),
+			Enabled:   convert.OptionalBool(flow.Enabled),
+			Condition: convert.OptionalString(flow.Condition),
+			Pre:       newSteps(flow.Pre),
+			Post:      newSteps(flow.Post),
+		})
+	}
+	return flows
+}
+
+// optionalString maps an empty string to null unless it is configured.
+func optionalString(source *string, prior types.String) types.String {
+	if (source != nil && *source != "") || !prior.IsNull() {
+		return convert.String(source)
+	}
+	return types.StringNull()
+}
+
+func mapSteps(source *[]client.Step, prior []FlowStep) []FlowStep {
+	if source == nil || len(*source) == 0 {
+		if prior == nil {
+			return nil
+		}
+		return []FlowStep{}
+	}
+	steps := []FlowStep{}
+	for i, step := range *source {
+		var priorStep FlowStep
+		if i < len(prior) {
+			priorStep = prior[i]
+		}
+		configuration := convert.JSON(step.Configuration, priorStep.Configuration)
+		// AM returns an empty configuration for the steps without one.
+		if priorStep.Configuration.IsNull() && convert.JSONEqual(configuration.ValueString(), "{}") {
+			configuration = types.StringNull()
+		}
+		steps = append(steps, FlowStep{
+			Policy:        convert.String(step.Policy),
+			Name:          convert.String(step.Name),
+			Description:   optionalString(step.Description, priorStep.Description),
+			Enabled:       types.BoolValue(step.Enabled == nil || *step.Enabled),
+			Condition:     optionalString(step.Condition, priorStep.Condition),
+			Configuration: configuration,
+		})
+	}
+	return steps
+}
+
+// MapFlowResource maps the ordered flows of a domain or an application, the
+// steps are compared with the prior ones at the same position.
+func MapFlowResource(source []client.FlowEntity, target FlowResourceModel) FlowResourceModel {
+	target.Id = types.StringValue(target.OrganizationId.ValueString() + ":" + target.EnvironmentId.ValueString() + ":" + target.DomainId.ValueString())
+	if !target.ApplicationId.IsNull() {
+		target.Id = types.StringValue(target.Id.ValueString() + ":" + target.ApplicationId.ValueString())
+	}
+
+	prior := target.Flows
+	target.Flows = []Flow{}
+	for i, flow := range source {
+		var priorFlow Flow
+		if i < len(prior) {
+			priorFlow = prior[i]
+		}
+		flowType := types.StringNull()
+		if flow.Type != nil {
+			flowType = types.StringValue(string(*flow.Type))
+		}
+		target.Flows = append(target.Flows, Flow{
+			FlowId:    convert.String(flow.Id),
+			Name:      convert.String(flow.Name),
+			Type:      flowType,
+			Enabled:   types.BoolValue(flow.Enabled == nil || *flow.Enabled),
+			Condition: optionalString(flow.Condition, priorFlow.Condition),
+			Pre:       mapSteps(flow.Pre, priorFlow.Pre),
+			Post:      mapSteps(flow.Post, priorFlow.Post),
+		})
+	}
+	return target
+}
+
+func getStepSchema(description string) schema.ListNestedAttribute {
+	return schema.ListNestedAttribute{
+		MarkdownDescription: description,
+		Optional:            true,
+		NestedObject: schema.NestedAttributeObject{
+			Attributes: map[string]schema.Attribute{
+				"policy": schema.StringAttribute{
+					MarkdownDescription: "Policy plugin id, such as `policy-am-enrich-profile`, `groovy` or `policy-http-callout`",
+					Required:            true,
+				},
+				"name": schema.StringAttribute{
+					MarkdownDescription: "Step name",
+					Required:            true,
+				},
+				"description": schema.StringAttribute{
+					MarkdownDescription: "Step description",
+					Optional:            true,
+				},
+				"enabled": schema.BoolAttribute{
+					MarkdownDescription: "Step executed",
+					Optional:            true,
+					Computed:            true,
+					Default:             booldefault.StaticBool(true),
+				},
+				"condition": schema.StringAttribute{
+					MarkdownDescription: "Expression Language condition for the step to be executed",
+					Optional:            true,
+				},
+				"configuration": schema.StringAttribute{
+					MarkdownDescription: "Policy configuration, a JSON document matching the policy schema",
+					Optional:            true,
+				},
+			},
+		},
+	}
+}
+
+func GetFlowResourceSchema() *schema.Schema {
+	return &schema.Schema{
+		MarkdownDescription: "Flow resource, the ordered policy flows of a security domain or an application. The flows left out of the configuration are deleted",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "TF identifier in the form organizationId:environmentId:domainId or organizationId:environmentId:domainId:applicationId",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"organization_id": schema.StringAttribute{
+				MarkdownDescription: "Organization id",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"environment_id": schema.StringAttribute{
+				MarkdownDescription: "Environment id",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"domain_id": schema.StringAttribute{
+				MarkdownDescription: "Domain id",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"application_id": schema.StringAttribute{
+				MarkdownDescription: "Application id, the flows of the domain when unset",
+				Optional:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"flows": schema.ListNestedAttribute{
+				MarkdownDescription: "Flows, in execution order",
+				Required:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"flow_id": schema.StringAttribute{
+							MarkdownDescription: "Flow id",
+							Computed:            true,
+							PlanModifiers: []planmodifier.String{
+								stringplanmodifier.UseStateForUnknown(),
+							},
+						},
+						"name": schema.StringAttribute{
+							MarkdownDescription: "Flow name",
+							Required:            true,
+						},
+						"type": schema.StringAttribute{
+							MarkdownDescription: "Flow type, the step of the authentication the flow is executed on such as `ROOT`, `LOGIN_IDENTIFIER`, `LOGIN`, `CONSENT`, `REGISTER`, `RESET_PASSWORD` or `REGISTRATION_CONFIRMATION`",
+							Required:            true,
+						},
+						"enabled": schema.BoolAttribute{
+							MarkdownDescription: "Flow executed",
+							Optional:            true,
+							Computed:            true,
+							Default:             booldefault.StaticBool(true),
+						},
+						"condition": schema.StringAttribute{
+							MarkdownDescription: "Expression Language condition for the flow to be executed",
+							Optional:            true,
+						},
+						"pre":  getStepSchema("Steps executed before the step of the authentication"),
+						"post": getStepSchema("Steps executed after the step of the authentication"),
+					},
+				},
+			},
+		},
+	}
+}
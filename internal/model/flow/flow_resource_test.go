@@ -0,0 +1,89 @@
+package flow
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/thornleyk/graviteeioam-service/client"
+)
+
+func testFlowResourceModel() FlowResourceModel {
+	return FlowResourceModel{
+		OrganizationId: types.StringValue("DEFAULT"),
+		EnvironmentId:  types.StringValue("DEFAULT"),
+		DomainId:       types.StringValue("domain"),
+		ApplicationId:  types.StringNull(),
+		Flows: []Flow{
+			{
+				FlowId:  types.StringUnknown(),
+				Name:    types.StringValue("ALL"),
+				Type:    types.StringValue("ROOT"),
+				Enabled: types.BoolValue(true),
+			},
+			{
+				FlowId:  types.StringUnknown(),
+				Name:    types.StringValue("LOGIN"),
+				Type:    types.StringValue("LOGIN"),
+				Enabled: types.BoolValue(true),
+				Pre: []FlowStep{
+					{
+						Policy:        types.StringValue("policy-am-enrich-profile"),
+						Name:          types.StringValue("Enrich profile"),
+						Enabled:       types.BoolValue(false),
+						Configuration: types.StringValue(`{"properties": [{"claim": "a", "claimValue": "b"}]}`),
+					},
+				},
+			},
+		},
+	}
+}
+
+func TestNewFlowsFromResource(t *testing.T) {
+	flows := NewFlowsFromResource(testFlowResourceModel())
+	if len(flows) != 2 || flows[0].Id != nil || flows[1].Type != client.FlowTypeLOGIN {
+		t.Fatalf("unexpected flows: %+v", flows)
+	}
+	if len(*flows[0].Pre) != 0 || len(*flows[1].Pre) != 1 || *(*flows[1].Pre)[0].Enabled {
+		t.Errorf("unexpected steps: %+v", flows)
+	}
+}
+
+func TestMapFlowResource(t *testing.T) {
+	var source []client.FlowEntity
+	payload := `[
+		{"id": "root", "name": "ALL", "type": "ROOT", "enabled": true, "condition": "", "pre": [], "post": []},
+		{"id": "login", "name": "LOGIN", "type": "LOGIN", "enabled": true, "pre": [
+			{"policy": "policy-am-enrich-profile", "name": "Enrich profile", "enabled": false, "description": "", "condition": "", "configuration": "{\"properties\":[{\"claim\":\"a\",\"claimValue\":\"b\"}]}"},
+			{"policy": "groovy", "name": "Groovy", "enabled": true, "configuration": "{}"}
+		]}
+	]`
+	if err := json.Unmarshal([]byte(payload), &source); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	data := testFlowResourceModel()
+	target := MapFlowResource(source, data)
+	if target.Id.ValueString() != "DEFAULT:DEFAULT:domain" || len(target.Flows) != 2 {
+		t.Fatalf("unexpected flows: %+v", target)
+	}
+	root := target.Flows[0]
+	if root.FlowId.ValueString() != "root" || !root.Condition.IsNull() || root.Pre != nil || root.Post != nil {
+		t.Errorf("expected the empty values of the root flow to stay null, got %+v", root)
+	}
+	login := target.Flows[1]
+	if len(login.Pre) != 2 || login.Post != nil {
+		t.Fatalf("unexpected steps: %+v", login)
+	}
+	if login.Pre[0].Configuration != data.Flows[1].Pre[0].Configuration || !login.Pre[0].Description.IsNull() || login.Pre[0].Enabled.ValueBool() {
+		t.Errorf("expected the step to match the prior one, got %+v", login.Pre[0])
+	}
+	if login.Pre[1].Policy.ValueString() != "groovy" || !login.Pre[1].Configuration.IsNull() {
+		t.Errorf("expected the added step to be mapped with a null configuration, got %+v", login.Pre[1])
+	}
+
+	data.ApplicationId = types.StringValue("application")
+	if target := MapFlowResource(nil, data); target.Id.ValueString() != "DEFAULT:DEFAULT:domain:application" || len(target.Flows) != 0 {
+		t.Errorf("unexpected application flows: %+v", target)
+	}
+}
@@ -0,0 +1,167 @@
+package form
+
+import (
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/thornleyk/graviteeioam-service/client"
+	"github.com/thornleyk/terraform-provider-graviteeioam/internal/model/convert"
+)
+
+// FormTemplates lists the pages that can be customized.
+var FormTemplates = []string{
+	"LOGIN",
+	"IDENTIFIER_FIRST_LOGIN",
+	"WEBAUTHN_LOGIN",
+	"WEBAUTHN_REGISTER",
+	"REGISTRATION",
+	"REGISTRATION_CONFIRMATION",
+	"FORGOT_PASSWORD",
+	"RESET_PASSWORD",
+	"OAUTH2_USER_CONSENT",
+	"MFA_ENROLL",
+	"MFA_CHALLENGE",
+	"MFA_CHALLENGE_ALTERNATIVES",
+	"MFA_RECOVERY_CODE",
+	"BLOCKED_ACCOUNT",
+	"COMPLETE_PROFILE",
+	"VERIFY_ATTEMPT",
+	"CERTIFICATE_EXPIRATION",
+	"ERROR",
+}
+
+type FormResourceModel struct {
+	Id             types.String `tfsdk:"id"`
+	OrganizationId types.String `tfsdk:"organization_id"`
+	EnvironmentId  types.String `tfsdk:"environment_id"`
+	DomainId       types.String `tfsdk:"domain_id"`
+	ApplicationId  types.String `tfsdk:"application_id"`
+	FormId         types.String `tfsdk:"form_id"`
+	Template       types.String `tfsdk:"template"`
+	Content        types.String `tfsdk:"content"`
+	Assets         types.String `tfsdk:"assets"`
+	Enabled        types.Bool   `tfsdk:"enabled"`
+}
+
+func NewFormFromResource(source FormResourceModel) client.NewForm {
+	return client.NewForm{
+		Template: client.NewFormTemplate(source.Template.ValueString()),
+		Content:  source.Content.ValueString(),
+		Assets:   convert.OptionalString(source.Assets),
+		Enabled:  convert.OptionalBool(source.Enabled),
+	}
+}
+
+func UpdateFormFromResource(source FormResourceModel) client.UpdateForm {
+	return client.UpdateForm{
+		Content: convert.OptionalString(source.Content),
+		Assets:  convert.OptionalString(source.Assets),
+		Enabled: convert.OptionalBool(source.Enabled),
+	}
+}
+
+// trimmedString returns the prior value when it only differs from the
+// source by trailing whitespace, which AM trims from the templates.
+func trimmedString(source *string, prior types.String) types.String {
+	if source == nil || (*source == "" && prior.IsNull()) {
+		return types.StringNull()
+	}
+	if !prior.IsNull() && !prior.IsUnknown() && strings.TrimRight(*source, " \t\r\n") == strings.TrimRight(prior.ValueString(), " \t\r\n") {
+		return prior
+	}
+	return types.StringValue(*source)
+}
+
+func MapFormResource(source *client.Form, target FormResourceModel) FormResourceModel {
+	target.FormId = convert.String(source.Id)
+	target.Id = types.StringValue(target.OrganizationId.ValueString() + ":" + target.EnvironmentId.ValueString() + ":" + target.DomainId.ValueString())
+	if !target.ApplicationId.IsNull() {
+		target.Id = types.StringValue(target.Id.ValueString() + ":" + target.ApplicationId.ValueString())
+	}
+	target.Id = types.StringValue(target.Id.ValueString() + ":" + target.Template.ValueString())
+	target.Content = trimmedString(source.Content, target.Content)
+	target.Assets = trimmedString(source.Assets, target.Assets)
+	target.Enabled = types.BoolValue(source.Enabled == nil || *source.Enabled)
+	return target
+}
+
+func GetFormResourceSchema() *schema.Schema {
+	return &schema.Schema{
+		MarkdownDescription: "Form resource, a custom HTML template of a page of a security domain or an application such as the login or registration page",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "TF identifier in the form organizationId:environmentId:domainId:template or organizationId:environmentId:domainId:applicationId:template",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"organization_id": schema.StringAttribute{
+				MarkdownDescription: "Organization id",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"environment_id": schema.StringAttribute{
+				MarkdownDescription: "Environment id",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"domain_id": schema.StringAttribute{
+				MarkdownDescription: "Domain id",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"application_id": schema.StringAttribute{
+				MarkdownDescription: "Application id, the form of the domain when unset",
+				Optional:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"form_id": schema.StringAttribute{
+				MarkdownDescription: "Form id",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"template": schema.StringAttribute{
+				MarkdownDescription: "Page of the form, such as `LOGIN`, `REGISTRATION`, `FORGOT_PASSWORD`, `MFA_CHALLENGE` or `ERROR`",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.OneOf(FormTemplates...),
+				},
+			},
+			"content": schema.StringAttribute{
+				MarkdownDescription: "Thymeleaf HTML template of the page, usually loaded with `file()`. Trailing whitespace is ignored",
+				Required:            true,
+			},
+			"assets": schema.StringAttribute{
+				MarkdownDescription: "Assets of the page, such as the CSS and images of the template editor",
+				Optional:            true,
+			},
+			"enabled": schema.BoolAttribute{
+				MarkdownDescription: "Form used instead of the default template",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(true),
+			},
+		},
+	}
+}
@@ -0,0 +1,53 @@
+package form
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/thornleyk/graviteeioam-service/client"
+)
+
+func TestMapFormResourceIgnoresTrailingWhitespace(t *testing.T) {
+	var source client.Form
+	payload := `{
+		"id": "form",
+		"template": "LOGIN",
+		"enabled": true,
+		"content": "<html>\n  <body>Login</body>\n</html>"
+	}`
+	if err := json.Unmarshal([]byte(payload), &source); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	data := FormResourceModel{
+		OrganizationId: types.StringValue("DEFAULT"),
+		EnvironmentId:  types.StringValue("DEFAULT"),
+		DomainId:       types.StringValue("domain"),
+		ApplicationId:  types.StringNull(),
+		Template:       types.StringValue("LOGIN"),
+		Content:        types.StringValue("<html>\n  <body>Login</body>\n</html>\n\n"),
+		Assets:         types.StringNull(),
+	}
+	target := MapFormResource(&source, data)
+	if target.Id.ValueString() != "DEFAULT:DEFAULT:domain:LOGIN" || target.FormId.ValueString() != "form" || !target.Enabled.ValueBool() {
+		t.Errorf("unexpected form: %+v", target)
+	}
+	if target.Content != data.Content {
+		t.Errorf("expected trailing whitespace to be ignored, got %q", target.Content.ValueString())
+	}
+	if !target.Assets.IsNull() {
+		t.Errorf("expected the assets to stay null, got %s", target.Assets)
+	}
+
+	changed := "<html>\n  <body>Sign in</body>\n</html>"
+	source.Content = &changed
+	data.ApplicationId = types.StringValue("application")
+	target = MapFormResource(&source, data)
+	if target.Content.ValueString() != changed {
+		t.Errorf("expected a changed content to show as a diff, got %q", target.Content.ValueString())
+	}
+	if target.Id.ValueString() != "DEFAULT:DEFAULT:domain:application:LOGIN" {
+		t.Errorf("unexpected id: %s", target.Id)
+	}
+}
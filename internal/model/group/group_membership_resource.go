@@ -0,0 +1,136 @@
+package group
+
+import (
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/thornleyk/graviteeioam-service/client"
+	"github.com/thornleyk/terraform-provider-graviteeioam/internal/model/convert"
+)
+
+type GroupMembershipResourceModel struct {
+	Id             types.String `tfsdk:"id"`
+	ReferenceType  types.String `tfsdk:"reference_type"`
+	OrganizationId types.String `tfsdk:"organization_id"`
+	EnvironmentId  types.String `tfsdk:"environment_id"`
+	DomainId       types.String `tfsdk:"domain_id"`
+	GroupId        types.String `tfsdk:"group_id"`
+	Members        types.Set    `tfsdk:"members"`
+}
+
+// MembersDiff returns the members to add and to remove to go from the prior
+// to the planned members, other members of the group are left untouched.
+func MembersDiff(prior []string, planned []string) ([]string, []string) {
+	added := difference(planned, prior)
+	removed := difference(prior, planned)
+	return added, removed
+}
+
+// difference returns the sorted values of a that are not in b.
+func difference(a []string, b []string) []string {
+	excluded := map[string]bool{}
+	for _, value := range b {
+		excluded[value] = true
+	}
+	target := []string{}
+	for _, value := range a {
+		if !excluded[value] {
+			target = append(target, value)
+		}
+	}
+	sort.Strings(target)
+	return target
+}
+
+// intersection returns the sorted values of a that are also in b.
+func intersection(a []string, b []string) []string {
+	return difference(a, difference(a, b))
+}
+
+// MapGroupMembershipResource keeps the managed members that still belong to
+// the group, every member is adopted when none is managed yet, such as on
+// import.
+func MapGroupMembershipResource(source *client.Group, managed []string, target GroupMembershipResourceModel) GroupMembershipResourceModel {
+	if target.ReferenceType.ValueString() == "domain" {
+		target.Id = types.StringValue(target.OrganizationId.ValueString() + ":" + target.EnvironmentId.ValueString() + ":" + target.DomainId.ValueString() + ":" + target.GroupId.ValueString())
+	} else {
+		target.Id = types.StringValue(target.OrganizationId.ValueString() + ":" + target.GroupId.ValueString())
+	}
+	members := []string{}
+	if source.Members != nil {
+		members = *source.Members
+	}
+	if managed != nil {
+		members = intersection(members, managed)
+	}
+	target.Members = convert.StringSet(&members)
+	return target
+}
+
+func GetGroupMembershipResourceSchema() *schema.Schema {
+	return &schema.Schema{
+		MarkdownDescription: "Group membership resource, non-authoritative management of the members of a group. Members added outside of Terraform, for example in the console, are left untouched",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "TF identifier in the form organizationId:environmentId:domainId:groupId for domain groups and organizationId:groupId for organization groups",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"reference_type": schema.StringAttribute{
+				MarkdownDescription: "Group reference type, one of `domain` or `organization`",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.OneOf("domain", "organization"),
+				},
+			},
+			"organization_id": schema.StringAttribute{
+				MarkdownDescription: "Organization id",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"environment_id": schema.StringAttribute{
+				MarkdownDescription: "Environment id, required for domain groups",
+				Optional:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"domain_id": schema.StringAttribute{
+				MarkdownDescription: "Domain id, required for domain groups",
+				Optional:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"group_id": schema.StringAttribute{
+				MarkdownDescription: "Group id",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"members": schema.SetAttribute{
+				MarkdownDescription: "Ids of the users managed as members of the group",
+				ElementType:         types.StringType,
+				Required:            true,
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+				},
+			},
+		},
+	}
+}
@@ -0,0 +1,47 @@
+package group
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/thornleyk/graviteeioam-service/client"
+)
+
+func TestMembersDiff(t *testing.T) {
+	added, removed := MembersDiff([]string{"alice", "bob"}, []string{"carol", "bob"})
+	if !reflect.DeepEqual(added, []string{"carol"}) || !reflect.DeepEqual(removed, []string{"alice"}) {
+		t.Errorf("unexpected diff: added %v, removed %v", added, removed)
+	}
+
+	added, removed = MembersDiff(nil, []string{"bob", "alice"})
+	if !reflect.DeepEqual(added, []string{"alice", "bob"}) || len(removed) != 0 {
+		t.Errorf("unexpected diff: added %v, removed %v", added, removed)
+	}
+}
+
+func TestMapGroupMembershipResource(t *testing.T) {
+	source := client.Group{Members: &[]string{"alice", "bob", "console-user"}}
+	target := GroupMembershipResourceModel{
+		ReferenceType:  types.StringValue("organization"),
+		OrganizationId: types.StringValue("DEFAULT"),
+		GroupId:        types.StringValue("group"),
+	}
+
+	// Members added in the console are ignored and removed members are
+	// reported so that they are added back.
+	mapped := MapGroupMembershipResource(&source, []string{"alice", "dave"}, target)
+	if mapped.Id.ValueString() != "DEFAULT:group" {
+		t.Errorf("unexpected id: %s", mapped.Id.ValueString())
+	}
+	if !mapped.Members.Equal(types.SetValueMust(types.StringType, []attr.Value{types.StringValue("alice")})) {
+		t.Errorf("unexpected members: %v", mapped.Members)
+	}
+
+	// Every member is adopted on import.
+	mapped = MapGroupMembershipResource(&source, nil, target)
+	if len(mapped.Members.Elements()) != 3 {
+		t.Errorf("unexpected imported members: %v", mapped.Members)
+	}
+}
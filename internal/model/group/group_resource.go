@@ -0,0 +1,141 @@
+package group
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/thornleyk/graviteeioam-service/client"
+	"github.com/thornleyk/terraform-provider-graviteeioam/internal/model/convert"
+)
+
+type GroupResourceModel struct {
+	Id             types.String `tfsdk:"id"`
+	ReferenceType  types.String `tfsdk:"reference_type"`
+	OrganizationId types.String `tfsdk:"organization_id"`
+	EnvironmentId  types.String `tfsdk:"environment_id"`
+	DomainId       types.String `tfsdk:"domain_id"`
+	GroupId        types.String `tfsdk:"group_id"`
+	Name           types.String `tfsdk:"name"`
+	Description    types.String `tfsdk:"description"`
+	Roles          types.Set    `tfsdk:"roles"`
+}
+
+func NewGroupFromResource(source GroupResourceModel) client.NewGroup {
+	return client.NewGroup{
+		Name:        source.Name.ValueString(),
+		Description: convert.OptionalString(source.Description),
+	}
+}
+
+// UpdateGroupFromResource returns the full update of a group. The members
+// of the current group are sent back unchanged, they are managed by the
+// group membership resource, and so are its roles when not configured.
+func UpdateGroupFromResource(ctx context.Context, source GroupResourceModel, current *client.Group) (client.UpdateGroup, diag.Diagnostics) {
+	roles, diags := convert.OptionalStrings(ctx, source.Roles)
+	if roles == nil {
+		roles = current.Roles
+	}
+	return client.UpdateGroup{
+		Name:        source.Name.ValueString(),
+		Description: convert.OptionalString(source.Description),
+		Members:     current.Members,
+		Roles:       roles,
+	}, diags
+}
+
+func MapGroupResource(source *client.Group, target GroupResourceModel) GroupResourceModel {
+	target.GroupId = convert.String(source.Id)
+	if target.ReferenceType.ValueString() == "domain" {
+		target.Id = types.StringValue(target.OrganizationId.ValueString() + ":" + target.EnvironmentId.ValueString() + ":" + target.DomainId.ValueString() + ":" + target.GroupId.ValueString())
+	} else {
+		target.Id = types.StringValue(target.OrganizationId.ValueString() + ":" + target.GroupId.ValueString())
+	}
+	target.Name = convert.String(source.Name)
+	if (source.Description != nil && *source.Description != "") || !target.Description.IsNull() {
+		target.Description = convert.String(source.Description)
+	}
+	roles := []string{}
+	if source.Roles != nil {
+		roles = *source.Roles
+	}
+	target.Roles = convert.StringSet(&roles)
+	return target
+}
+
+func GetGroupResourceSchema() *schema.Schema {
+	return &schema.Schema{
+		MarkdownDescription: "Group resource, a group of users of a security domain or of an organization. The group members are managed by the `graviteeioam_group_membership` resource",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "TF identifier in the form organizationId:environmentId:domainId:groupId for domain groups and organizationId:groupId for organization groups",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"reference_type": schema.StringAttribute{
+				MarkdownDescription: "Group reference type, one of `domain` or `organization`",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.OneOf("domain", "organization"),
+				},
+			},
+			"organization_id": schema.StringAttribute{
+				MarkdownDescription: "Organization id",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"environment_id": schema.StringAttribute{
+				MarkdownDescription: "Environment id, required for domain groups",
+				Optional:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"domain_id": schema.StringAttribute{
+				MarkdownDescription: "Domain id, required for domain groups",
+				Optional:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"group_id": schema.StringAttribute{
+				MarkdownDescription: "Group id",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Group name",
+				Required:            true,
+			},
+			"description": schema.StringAttribute{
+				MarkdownDescription: "Group description",
+				Optional:            true,
+			},
+			"roles": schema.SetAttribute{
+				MarkdownDescription: "Ids of the roles granted to the group members, the roles assigned in the console are kept when unset and revoked when set to an empty set",
+				ElementType:         types.StringType,
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.Set{
+					setplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
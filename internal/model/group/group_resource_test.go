@@ -0,0 +1,76 @@
+package group
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/thornleyk/graviteeioam-service/client"
+)
+
+func testGroupResourceModel() GroupResourceModel {
+	return GroupResourceModel{
+		ReferenceType:  types.StringValue("domain"),
+		OrganizationId: types.StringValue("DEFAULT"),
+		EnvironmentId:  types.StringValue("DEFAULT"),
+		DomainId:       types.StringValue("domain"),
+		Name:           types.StringValue("Support"),
+		Description:    types.StringNull(),
+		Roles:          types.SetValueMust(types.StringType, []attr.Value{types.StringValue("role")}),
+	}
+}
+
+func TestUpdateGroupFromResourceKeepsMembers(t *testing.T) {
+	current := client.Group{
+		Members: &[]string{"alice", "bob"},
+		Roles:   &[]string{"console-role"},
+	}
+
+	update, diags := UpdateGroupFromResource(context.Background(), testGroupResourceModel(), &current)
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+	if update.Name != "Support" || update.Members == nil || len(*update.Members) != 2 {
+		t.Errorf("expected the current members to be kept, got %+v", update)
+	}
+	if update.Roles == nil || len(*update.Roles) != 1 || (*update.Roles)[0] != "role" {
+		t.Errorf("unexpected roles: %v", update.Roles)
+	}
+
+	source := testGroupResourceModel()
+	source.Roles = types.SetUnknown(types.StringType)
+	update, _ = UpdateGroupFromResource(context.Background(), source, &current)
+	if update.Roles == nil || (*update.Roles)[0] != "console-role" {
+		t.Errorf("expected unset roles to be kept, got %v", update.Roles)
+	}
+}
+
+func TestMapGroupResource(t *testing.T) {
+	var source client.Group
+	payload := `{
+		"id": "group",
+		"name": "Support",
+		"description": "",
+		"members": ["alice"]
+	}`
+	if err := json.Unmarshal([]byte(payload), &source); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	target := MapGroupResource(&source, testGroupResourceModel())
+	if target.Id.ValueString() != "DEFAULT:DEFAULT:domain:group" || target.GroupId.ValueString() != "group" || !target.Description.IsNull() {
+		t.Errorf("unexpected group: %+v", target)
+	}
+	if target.Roles.IsNull() || len(target.Roles.Elements()) != 0 {
+		t.Errorf("unexpected roles: %v", target.Roles)
+	}
+
+	organizationGroup := testGroupResourceModel()
+	organizationGroup.ReferenceType = types.StringValue("organization")
+	target = MapGroupResource(&source, organizationGroup)
+	if target.Id.ValueString() != "DEFAULT:group" {
+		t.Errorf("unexpected organization group id: %s", target.Id.ValueString())
+	}
+}
@@ -4,27 +4,37 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/thornleyk/graviteeioam-service/client"
+	"github.com/thornleyk/terraform-provider-graviteeioam/internal/model/convert"
 )
 
+// Organization is the organization returned by the management API. The
+// generated client.Organization lacks the domain restrictions AM returns.
+type Organization struct {
+	client.Organization
+	DomainRestrictions *[]string `json:"domainRestrictions,omitempty"`
+}
+
 type OrganizationDataSourceModel struct {
-	Id             types.String   `tfsdk:"id"`
-	OrganizationId types.String   `tfsdk:"organization_id"`
-	Name           types.String   `tfsdk:"name"`
-	Identities     []types.String `tfsdk:"identities"`
-	HrIds          []types.String `tfsdk:"hrids"`
+	Id                 types.String `tfsdk:"id"`
+	OrganizationId     types.String `tfsdk:"organization_id"`
+	Name               types.String `tfsdk:"name"`
+	Description        types.String `tfsdk:"description"`
+	Identities         types.List   `tfsdk:"identities"`
+	HrIds              types.List   `tfsdk:"hrids"`
+	DomainRestrictions types.List   `tfsdk:"domain_restrictions"`
+	CreatedAt          types.String `tfsdk:"created_at"`
+	UpdatedAt          types.String `tfsdk:"updated_at"`
 }
 
-func MapOrganizationDataSource(source *client.Organization, target OrganizationDataSourceModel) (OrganizationDataSourceModel, error) {
+func MapOrganizationDataSource(source *Organization, target OrganizationDataSourceModel) (OrganizationDataSourceModel, error) {
 	target.Id = target.OrganizationId
-	target.Name = types.StringValue(*source.Name)
-
-	for _, hrid := range *source.Hrids {
-		target.HrIds = append(target.Identities, types.StringValue(hrid))
-	}
-
-	for _, identity := range *source.Identities {
-		target.Identities = append(target.Identities, types.StringValue(identity))
-	}
+	target.Name = convert.String(source.Name)
+	target.Description = convert.String(source.Description)
+	target.HrIds = convert.StringList(source.Hrids)
+	target.Identities = convert.StringList(source.Identities)
+	target.DomainRestrictions = convert.StringList(source.DomainRestrictions)
+	target.CreatedAt = convert.Timestamp(source.CreatedAt)
+	target.UpdatedAt = convert.Timestamp(source.UpdatedAt)
 	return target, nil
 }
 
@@ -45,8 +55,12 @@ func GetOrganizationDataSourceSchema() *schema.Schema {
 				MarkdownDescription: "Organization name",
 				Computed:            true,
 			},
+			"description": schema.StringAttribute{
+				MarkdownDescription: "Organization description",
+				Computed:            true,
+			},
 			"identities": schema.ListAttribute{
-				MarkdownDescription: "Organization identities",
+				MarkdownDescription: "Identity providers used to log in to the organization",
 				ElementType:         types.StringType,
 				Computed:            true,
 			},
@@ -55,6 +69,19 @@ func GetOrganizationDataSourceSchema() *schema.Schema {
 				ElementType:         types.StringType,
 				Computed:            true,
 			},
+			"domain_restrictions": schema.ListAttribute{
+				MarkdownDescription: "Domains the organization is restricted to",
+				ElementType:         types.StringType,
+				Computed:            true,
+			},
+			"created_at": schema.StringAttribute{
+				MarkdownDescription: "Creation date, in RFC 3339 format",
+				Computed:            true,
+			},
+			"updated_at": schema.StringAttribute{
+				MarkdownDescription: "Last update date, in RFC 3339 format",
+				Computed:            true,
+			},
 		},
 	}
 }
@@ -0,0 +1,61 @@
+package organization
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestMapOrganizationDataSourceMinimalPayload(t *testing.T) {
+	var source Organization
+	if err := json.Unmarshal([]byte(`{"id":"DEFAULT"}`), &source); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	target, err := MapOrganizationDataSource(&source, OrganizationDataSourceModel{OrganizationId: types.StringValue("DEFAULT")})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if target.Id.ValueString() != "DEFAULT" || !target.Name.IsNull() || !target.Description.IsNull() || !target.CreatedAt.IsNull() {
+		t.Errorf("expected missing fields to be null, got %+v", target)
+	}
+	if !target.HrIds.IsNull() || !target.Identities.IsNull() || !target.DomainRestrictions.IsNull() {
+		t.Errorf("expected missing lists to be null, got %+v", target)
+	}
+}
+
+func TestMapOrganizationDataSource(t *testing.T) {
+	var source Organization
+	payload := `{
+		"id": "DEFAULT",
+		"name": "Default organization",
+		"description": "Default organization",
+		"hrids": ["default"],
+		"identities": ["gravitee", "github"],
+		"domainRestrictions": ["example.com"],
+		"createdAt": 1700000000000,
+		"updatedAt": 1700000060000
+	}`
+	if err := json.Unmarshal([]byte(payload), &source); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	target, err := MapOrganizationDataSource(&source, OrganizationDataSourceModel{OrganizationId: types.StringValue("DEFAULT")})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if !target.HrIds.Equal(types.ListValueMust(types.StringType, []attr.Value{types.StringValue("default")})) {
+		t.Errorf("unexpected hrids: %v", target.HrIds)
+	}
+	if len(target.Identities.Elements()) != 2 || target.Identities.Elements()[1] != types.StringValue("github") {
+		t.Errorf("unexpected identities: %v", target.Identities)
+	}
+	if !target.DomainRestrictions.Equal(types.ListValueMust(types.StringType, []attr.Value{types.StringValue("example.com")})) {
+		t.Errorf("unexpected domain restrictions: %v", target.DomainRestrictions)
+	}
+	if target.CreatedAt.ValueString() != "2023-11-14T22:13:20Z" || target.UpdatedAt.ValueString() != "2023-11-14T22:14:20Z" {
+		t.Errorf("unexpected timestamps: %s, %s", target.CreatedAt, target.UpdatedAt)
+	}
+}
@@ -4,15 +4,61 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/thornleyk/graviteeioam-service/client"
+	"github.com/thornleyk/terraform-provider-graviteeioam/internal/model/convert"
 )
 
+type OrganizationIdentityUserMapper struct {
+	Mapping types.String `tfsdk:"mapping"`
+}
+
+type OrganizationIdentityRoleMapper struct {
+	Mapping types.List `tfsdk:"mapping"`
+}
+
 type OrganizationIdentityDataSourceModel struct {
-	Id             types.String `tfsdk:"id"`
-	OrganizationId types.String `tfsdk:"organization_id"`
+	Id                 types.String                              `tfsdk:"id"`
+	OrganizationId     types.String                              `tfsdk:"organization_id"`
+	IdentityProviderId types.String                              `tfsdk:"identity_provider_id"`
+	Name               types.String                              `tfsdk:"name"`
+	Type               types.String                              `tfsdk:"type"`
+	System             types.Bool                                `tfsdk:"system"`
+	Configuration      types.String                              `tfsdk:"configuration"`
+	UserMappers        map[string]OrganizationIdentityUserMapper `tfsdk:"user_mappers"`
+	RoleMappers        map[string]OrganizationIdentityRoleMapper `tfsdk:"role_mappers"`
+	ReferenceType      types.String                              `tfsdk:"reference_type"`
+	ReferenceId        types.String                              `tfsdk:"reference_id"`
+	External           types.Bool                                `tfsdk:"external"`
+	Whitelist          types.List                                `tfsdk:"whitelist"`
 }
 
 func MapOrganizationIdentityDataSource(source *client.IdentityProvider, target OrganizationIdentityDataSourceModel) (OrganizationIdentityDataSourceModel, error) {
-
+	target.Id = types.StringValue(target.OrganizationId.ValueString() + ":" + target.IdentityProviderId.ValueString())
+	target.Name = convert.String(source.Name)
+	target.Type = convert.String(source.Type)
+	target.System = convert.Bool(source.System)
+	target.Configuration = convert.NormalizedJSON(source.Configuration)
+	target.UserMappers = nil
+	if source.Mappers != nil {
+		target.UserMappers = map[string]OrganizationIdentityUserMapper{}
+		for attribute, mapping := range *source.Mappers {
+			target.UserMappers[attribute] = OrganizationIdentityUserMapper{Mapping: types.StringValue(mapping)}
+		}
+	}
+	target.RoleMappers = nil
+	if source.RoleMapper != nil {
+		target.RoleMappers = map[string]OrganizationIdentityRoleMapper{}
+		for role, rules := range *source.RoleMapper {
+			rules := rules
+			target.RoleMappers[role] = OrganizationIdentityRoleMapper{Mapping: convert.StringList(&rules)}
+		}
+	}
+	target.ReferenceType = types.StringNull()
+	if source.ReferenceType != nil {
+		target.ReferenceType = types.StringValue(string(*source.ReferenceType))
+	}
+	target.ReferenceId = convert.String(source.ReferenceId)
+	target.External = convert.Bool(source.External)
+	target.Whitelist = convert.StringList(source.DomainWhitelist)
 	return target, nil
 }
 
@@ -21,13 +67,17 @@ func GetOrganizationIdentityDataSourceSchema() *schema.Schema {
 		MarkdownDescription: "OrganizationIdentity data source",
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{
-				MarkdownDescription: "TF identifier",
+				MarkdownDescription: "TF identifier in the form organizationId:identityProviderId",
 				Computed:            true,
 			},
 			"organization_id": schema.StringAttribute{
 				MarkdownDescription: "Organization id",
 				Required:            true,
 			},
+			"identity_provider_id": schema.StringAttribute{
+				MarkdownDescription: "Organization Identity id",
+				Required:            true,
+			},
 			"name": schema.StringAttribute{
 				MarkdownDescription: "Organization Identity name",
 				Computed:            true,
@@ -41,8 +91,9 @@ func GetOrganizationIdentityDataSourceSchema() *schema.Schema {
 				Computed:            true,
 			},
 			"configuration": schema.StringAttribute{
-				MarkdownDescription: "Organization Identity configuration",
+				MarkdownDescription: "Organization Identity configuration, a normalized JSON document",
 				Computed:            true,
+				Sensitive:           true,
 			},
 			"user_mappers": schema.MapNestedAttribute{
 				MarkdownDescription: "Organization Identity user mapping",
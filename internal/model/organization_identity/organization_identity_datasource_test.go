@@ -0,0 +1,68 @@
+package organization_identity
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/thornleyk/graviteeioam-service/client"
+)
+
+func TestMapOrganizationIdentityDataSourceMinimalPayload(t *testing.T) {
+	var source client.IdentityProvider
+	if err := json.Unmarshal([]byte(`{"id":"gravitee"}`), &source); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	target, err := MapOrganizationIdentityDataSource(&source, OrganizationIdentityDataSourceModel{OrganizationId: types.StringValue("DEFAULT"), IdentityProviderId: types.StringValue("gravitee")})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if target.Id.ValueString() != "DEFAULT:gravitee" || !target.Name.IsNull() || !target.System.IsNull() || !target.ReferenceType.IsNull() {
+		t.Errorf("expected missing fields to be null, got %+v", target)
+	}
+	if target.UserMappers != nil || target.RoleMappers != nil || !target.Whitelist.IsNull() {
+		t.Errorf("expected missing collections to be null, got %+v", target)
+	}
+}
+
+func TestMapOrganizationIdentityDataSource(t *testing.T) {
+	var source client.IdentityProvider
+	payload := `{
+		"id": "gravitee",
+		"name": "Gravitee",
+		"type": "gravitee-am-idp",
+		"system": true,
+		"configuration": "{\"b\": 1, \"a\": \"x\"}",
+		"mappers": {"email": "mail"},
+		"roleMapper": {"admin": ["groups=admins"]},
+		"referenceType": "ORGANIZATION",
+		"referenceId": "DEFAULT",
+		"external": false,
+		"domainWhitelist": ["example.com"]
+	}`
+	if err := json.Unmarshal([]byte(payload), &source); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	target, err := MapOrganizationIdentityDataSource(&source, OrganizationIdentityDataSourceModel{OrganizationId: types.StringValue("DEFAULT"), IdentityProviderId: types.StringValue("gravitee")})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if target.Name.ValueString() != "Gravitee" || target.Type.ValueString() != "gravitee-am-idp" || !target.System.ValueBool() || target.External.ValueBool() {
+		t.Errorf("unexpected identity provider: %+v", target)
+	}
+	if target.ReferenceType.ValueString() != "ORGANIZATION" || target.ReferenceId.ValueString() != "DEFAULT" || target.Configuration.ValueString() != `{"a":"x","b":1}` {
+		t.Errorf("unexpected reference: %+v", target)
+	}
+	if target.UserMappers["email"].Mapping.ValueString() != "mail" {
+		t.Errorf("unexpected user mappers: %v", target.UserMappers)
+	}
+	if !target.RoleMappers["admin"].Mapping.Equal(types.ListValueMust(types.StringType, []attr.Value{types.StringValue("groups=admins")})) {
+		t.Errorf("unexpected role mappers: %v", target.RoleMappers)
+	}
+	if !target.Whitelist.Equal(types.ListValueMust(types.StringType, []attr.Value{types.StringValue("example.com")})) {
+		t.Errorf("unexpected whitelist: %v", target.Whitelist)
+	}
+}
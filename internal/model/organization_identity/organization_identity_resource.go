@@ -1,16 +1,160 @@
 package organization_identity
 
 import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/thornleyk/graviteeioam-service/client"
+	"github.com/thornleyk/terraform-provider-graviteeioam/internal/model/convert"
 )
 
 type OrganizationIdentityResourceModel struct {
-	Id types.String `tfsdk:"id"`
+	Id                 types.String `tfsdk:"id"`
+	OrganizationId     types.String `tfsdk:"organization_id"`
+	IdentityProviderId types.String `tfsdk:"identity_provider_id"`
+	Type               types.String `tfsdk:"type"`
+	Name               types.String `tfsdk:"name"`
+	Configuration      types.String `tfsdk:"configuration"`
+	UserMappers        types.Map    `tfsdk:"user_mappers"`
+	RoleMappers        types.Map    `tfsdk:"role_mappers"`
+	LoginPage          types.Bool   `tfsdk:"login_page"`
+	System             types.Bool   `tfsdk:"system"`
+}
+
+func NewOrganizationIdentityFromResource(source OrganizationIdentityResourceModel) client.NewIdentityProvider {
+	return client.NewIdentityProvider{
+		Type:          source.Type.ValueString(),
+		Name:          source.Name.ValueString(),
+		Configuration: source.Configuration.ValueString(),
+	}
+}
+
+// UpdateOrganizationIdentityFromResource returns the full update of an
+// identity provider, mappers removed from the configuration are cleared.
+func UpdateOrganizationIdentityFromResource(ctx context.Context, source OrganizationIdentityResourceModel) (client.UpdateIdentityProvider, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	target := client.UpdateIdentityProvider{
+		Name:          source.Name.ValueString(),
+		Configuration: source.Configuration.ValueString(),
+		Mappers:       &map[string]string{},
+		RoleMapper:    &map[string][]string{},
+	}
+
+	userMappers, valueDiags := convert.OptionalStringMap(ctx, source.UserMappers)
+	diags.Append(valueDiags...)
+	if userMappers != nil {
+		target.Mappers = userMappers
+	}
+	roleMappers, valueDiags := convert.OptionalStringListMap(ctx, source.RoleMappers)
+	diags.Append(valueDiags...)
+	if roleMappers != nil {
+		target.RoleMapper = roleMappers
+	}
+	return target, diags
+}
+
+// MapOrganizationIdentityResource maps an organization identity provider,
+// loginPage tells whether the organization lists it on the console login
+// page.
+func MapOrganizationIdentityResource(source *client.IdentityProvider, loginPage bool, target OrganizationIdentityResourceModel) OrganizationIdentityResourceModel {
+	target.IdentityProviderId = convert.String(source.Id)
+	target.Id = types.StringValue(target.OrganizationId.ValueString() + ":" + target.IdentityProviderId.ValueString())
+	target.Type = convert.String(source.Type)
+	target.Name = convert.String(source.Name)
+	target.Configuration = convert.JSON(convert.UnmaskedJSON(source.Configuration, target.Configuration), target.Configuration)
+	target.LoginPage = types.BoolValue(loginPage)
+	target.System = types.BoolValue(source.System != nil && *source.System)
+
+	// Empty mappers are returned when unset, they are kept null unless
+	// configured.
+	if (source.Mappers != nil && len(*source.Mappers) > 0) || !target.UserMappers.IsNull() {
+		mappers := map[string]string{}
+		if source.Mappers != nil {
+			mappers = *source.Mappers
+		}
+		target.UserMappers = convert.StringMap(&mappers)
+	}
+	if (source.RoleMapper != nil && len(*source.RoleMapper) > 0) || !target.RoleMappers.IsNull() {
+		roleMappers := map[string][]string{}
+		if source.RoleMapper != nil {
+			roleMappers = *source.RoleMapper
+		}
+		target.RoleMappers = convert.StringListMap(&roleMappers)
+	}
+	return target
 }
 
 func GetOrganizationIdentityResourceSchema() *schema.Schema {
 	return &schema.Schema{
-		MarkdownDescription: "OrganizationIdentity resource",
+		MarkdownDescription: "OrganizationIdentity resource, an identity provider used to log in to the management console",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "TF identifier in the form organizationId:identityProviderId",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"organization_id": schema.StringAttribute{
+				MarkdownDescription: "Organization id",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"identity_provider_id": schema.StringAttribute{
+				MarkdownDescription: "Organization Identity id",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"type": schema.StringAttribute{
+				MarkdownDescription: "Organization Identity type, the identity provider plugin id such as `ldap-am-idp` or `oauth2-generic-am-idp`",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Organization Identity name",
+				Required:            true,
+			},
+			"configuration": schema.StringAttribute{
+				MarkdownDescription: "Organization Identity configuration, a JSON document matching the plugin schema",
+				Required:            true,
+				Sensitive:           true,
+			},
+			"user_mappers": schema.MapAttribute{
+				MarkdownDescription: "Organization Identity user mapping, from user profile attribute to identity provider attribute",
+				ElementType:         types.StringType,
+				Optional:            true,
+			},
+			"role_mappers": schema.MapAttribute{
+				MarkdownDescription: "Organization Identity role mapping, from role id to the user attribute rules granting it",
+				ElementType:         types.ListType{ElemType: types.StringType},
+				Optional:            true,
+			},
+			"login_page": schema.BoolAttribute{
+				MarkdownDescription: "Organization Identity shown on the management console login page",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"system": schema.BoolAttribute{
+				MarkdownDescription: "Organization Identity system provided identity",
+				Computed:            true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
 	}
 }
@@ -0,0 +1,81 @@
+package organization_identity
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/thornleyk/graviteeioam-service/client"
+)
+
+func testOrganizationIdentityResourceModel() OrganizationIdentityResourceModel {
+	return OrganizationIdentityResourceModel{
+		OrganizationId: types.StringValue("DEFAULT"),
+		Type:           types.StringValue("inline-am-idp"),
+		Name:           types.StringValue("inline"),
+		Configuration:  types.StringValue(`{"users": [], "passwordEncoder": "BCrypt"}`),
+		UserMappers:    types.MapNull(types.StringType),
+		RoleMappers: types.MapValueMust(types.ListType{ElemType: types.StringType}, map[string]attr.Value{
+			"admin": types.ListValueMust(types.StringType, []attr.Value{types.StringValue("groups=admins")}),
+		}),
+		LoginPage: types.BoolValue(true),
+		System:    types.BoolUnknown(),
+	}
+}
+
+func TestUpdateOrganizationIdentityFromResourceClearsUnsetValues(t *testing.T) {
+	update, diags := UpdateOrganizationIdentityFromResource(context.Background(), testOrganizationIdentityResourceModel())
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+	if rules := (*update.RoleMapper)["admin"]; len(rules) != 1 || rules[0] != "groups=admins" {
+		t.Errorf("unexpected role mappers: %v", update.RoleMapper)
+	}
+	if update.Mappers == nil || len(*update.Mappers) != 0 {
+		t.Errorf("expected unset user mappers to be cleared, got %+v", update)
+	}
+}
+
+func TestMapOrganizationIdentityResource(t *testing.T) {
+	var source client.IdentityProvider
+	payload := `{
+		"id": "idp",
+		"type": "inline-am-idp",
+		"name": "inline",
+		"system": false,
+		"configuration": "{\"passwordEncoder\":\"BCrypt\",\"users\":[]}",
+		"mappers": {},
+		"roleMapper": {"admin": ["groups=admins"]}
+	}`
+	if err := json.Unmarshal([]byte(payload), &source); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	data := testOrganizationIdentityResourceModel()
+	target := MapOrganizationIdentityResource(&source, false, data)
+	if target.Id.ValueString() != "DEFAULT:idp" || target.IdentityProviderId.ValueString() != "idp" || target.System.ValueBool() {
+		t.Errorf("unexpected identity provider: %+v", target)
+	}
+	if target.LoginPage.ValueBool() {
+		t.Errorf("expected the login page flag to follow the organization identities")
+	}
+	if target.Configuration != data.Configuration {
+		t.Errorf("expected the configured JSON to be kept, got %s", target.Configuration)
+	}
+	if !target.UserMappers.IsNull() || !target.RoleMappers.Equal(data.RoleMappers) {
+		t.Errorf("unexpected mappers: %+v", target)
+	}
+}
+
+func TestMapOrganizationIdentityResourceUnmasksConfiguration(t *testing.T) {
+	configuration := `{"clientId":"client","clientSecret":"********"}`
+	data := testOrganizationIdentityResourceModel()
+	data.Configuration = types.StringValue(`{"clientId": "client", "clientSecret": "secret"}`)
+
+	target := MapOrganizationIdentityResource(&client.IdentityProvider{Configuration: &configuration}, true, data)
+	if target.Configuration != data.Configuration {
+		t.Errorf("expected the masked secret to keep the configured value, got %s", target.Configuration)
+	}
+}
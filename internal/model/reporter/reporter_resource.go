@@ -0,0 +1,157 @@
+package reporter
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/thornleyk/graviteeioam-service/client"
+	"github.com/thornleyk/terraform-provider-graviteeioam/internal/model/convert"
+)
+
+type ReporterResourceModel struct {
+	Id             types.String `tfsdk:"id"`
+	ReferenceType  types.String `tfsdk:"reference_type"`
+	OrganizationId types.String `tfsdk:"organization_id"`
+	EnvironmentId  types.String `tfsdk:"environment_id"`
+	DomainId       types.String `tfsdk:"domain_id"`
+	ReporterId     types.String `tfsdk:"reporter_id"`
+	Name           types.String `tfsdk:"name"`
+	Type           types.String `tfsdk:"type"`
+	Enabled        types.Bool   `tfsdk:"enabled"`
+	Configuration  types.String `tfsdk:"configuration"`
+	Default        types.Bool   `tfsdk:"default"`
+}
+
+func NewReporterFromResource(source ReporterResourceModel) client.NewReporter {
+	return client.NewReporter{
+		Name:          source.Name.ValueString(),
+		Type:          source.Type.ValueString(),
+		Enabled:       convert.OptionalBool(source.Enabled),
+		Configuration: source.Configuration.ValueString(),
+	}
+}
+
+func UpdateReporterFromResource(source ReporterResourceModel) client.UpdateReporter {
+	return client.UpdateReporter{
+		Name:          source.Name.ValueString(),
+		Enabled:       convert.OptionalBool(source.Enabled),
+		Configuration: source.Configuration.ValueString(),
+	}
+}
+
+// FindDefaultReporter returns the default reporter of a domain or of an
+// organization, the system reporter created with it, or nil.
+func FindDefaultReporter(reporters []client.Reporter) *client.Reporter {
+	for i := range reporters {
+		if reporters[i].System != nil && *reporters[i].System {
+			return &reporters[i]
+		}
+	}
+	return nil
+}
+
+func MapReporterResource(source *client.Reporter, target ReporterResourceModel) ReporterResourceModel {
+	target.ReporterId = convert.String(source.Id)
+	if target.ReferenceType.ValueString() == "domain" {
+		target.Id = types.StringValue(target.OrganizationId.ValueString() + ":" + target.EnvironmentId.ValueString() + ":" + target.DomainId.ValueString() + ":" + target.ReporterId.ValueString())
+	} else {
+		target.Id = types.StringValue(target.OrganizationId.ValueString() + ":" + target.ReporterId.ValueString())
+	}
+	target.Name = convert.String(source.Name)
+	target.Type = convert.String(source.Type)
+	target.Enabled = types.BoolValue(source.Enabled != nil && *source.Enabled)
+	target.Default = types.BoolValue(source.System != nil && *source.System)
+	// AM masks the sensitive fields of the configuration, such as passwords.
+	target.Configuration = convert.JSON(convert.UnmaskedJSON(source.Configuration, target.Configuration), target.Configuration)
+	return target
+}
+
+func GetReporterResourceSchema() *schema.Schema {
+	return &schema.Schema{
+		MarkdownDescription: "Reporter resource, a destination of the audit events of a security domain or of an organization",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "TF identifier in the form organizationId:environmentId:domainId:reporterId for domain reporters and organizationId:reporterId for organization reporters",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"reference_type": schema.StringAttribute{
+				MarkdownDescription: "Reporter reference type, one of `domain` or `organization`",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.OneOf("domain", "organization"),
+				},
+			},
+			"organization_id": schema.StringAttribute{
+				MarkdownDescription: "Organization id",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"environment_id": schema.StringAttribute{
+				MarkdownDescription: "Environment id, required for domain reporters",
+				Optional:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"domain_id": schema.StringAttribute{
+				MarkdownDescription: "Domain id, required for domain reporters",
+				Optional:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"reporter_id": schema.StringAttribute{
+				MarkdownDescription: "Reporter id",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Reporter name",
+				Required:            true,
+			},
+			"type": schema.StringAttribute{
+				MarkdownDescription: "Reporter type, the reporter plugin id such as `mongodb`, `reporter-am-jdbc`, `reporter-am-file` or `reporter-am-kafka`",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"enabled": schema.BoolAttribute{
+				MarkdownDescription: "Reporter receiving the audit events",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(true),
+			},
+			"configuration": schema.StringAttribute{
+				MarkdownDescription: "Reporter configuration, a JSON document matching the plugin schema. The sensitive fields masked by AM are compared with the configured values",
+				Required:            true,
+				Sensitive:           true,
+			},
+			"default": schema.BoolAttribute{
+				MarkdownDescription: "Adopt the default reporter created with the domain or the organization, the MongoDB or JDBC reporter of the repository, instead of creating a reporter. The default reporter is left in AM when the resource is destroyed",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+	}
+}
@@ -0,0 +1,65 @@
+package reporter
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/thornleyk/graviteeioam-service/client"
+)
+
+func TestFindDefaultReporter(t *testing.T) {
+	var reporters []client.Reporter
+	payload := `[
+		{"id": "file", "name": "Audit file", "type": "reporter-am-file", "system": false},
+		{"id": "default", "name": "MongoDB Reporter", "type": "mongodb", "system": true}
+	]`
+	if err := json.Unmarshal([]byte(payload), &reporters); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if reporter := FindDefaultReporter(reporters); reporter == nil || *reporter.Id != "default" {
+		t.Errorf("unexpected default reporter: %+v", reporter)
+	}
+	if reporter := FindDefaultReporter(reporters[:1]); reporter != nil {
+		t.Errorf("expected no default reporter, got %+v", reporter)
+	}
+}
+
+func TestMapReporterResource(t *testing.T) {
+	var source client.Reporter
+	payload := `{
+		"id": "default",
+		"name": "MongoDB Reporter",
+		"type": "mongodb",
+		"enabled": true,
+		"system": true,
+		"configuration": "{\"uri\":\"mongodb://localhost:27017\",\"password\":\"********\"}"
+	}`
+	if err := json.Unmarshal([]byte(payload), &source); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	data := ReporterResourceModel{
+		ReferenceType:  types.StringValue("domain"),
+		OrganizationId: types.StringValue("DEFAULT"),
+		EnvironmentId:  types.StringValue("DEFAULT"),
+		DomainId:       types.StringValue("domain"),
+		Configuration:  types.StringValue(`{"uri": "mongodb://localhost:27017", "password": "secret"}`),
+	}
+	target := MapReporterResource(&source, data)
+	if target.Id.ValueString() != "DEFAULT:DEFAULT:domain:default" || !target.Enabled.ValueBool() || !target.Default.ValueBool() {
+		t.Errorf("unexpected reporter: %+v", target)
+	}
+	if target.Configuration != data.Configuration {
+		t.Errorf("expected the masked configuration to match the prior one, got %s", target.Configuration)
+	}
+
+	data.ReferenceType = types.StringValue("organization")
+	data.EnvironmentId = types.StringNull()
+	data.DomainId = types.StringNull()
+	target = MapReporterResource(&source, data)
+	if target.Id.ValueString() != "DEFAULT:default" {
+		t.Errorf("unexpected organization reporter id: %s", target.Id)
+	}
+}
@@ -0,0 +1,232 @@
+package role
+
+import (
+	"context"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/mapvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/thornleyk/graviteeioam-service/client"
+	"github.com/thornleyk/terraform-provider-graviteeioam/internal/model/convert"
+)
+
+// Permissions lists the management permissions a role can grant.
+var Permissions = []string{
+	"ORGANIZATION", "ORGANIZATION_SETTINGS", "ORGANIZATION_IDENTITY_PROVIDER", "ORGANIZATION_AUDIT",
+	"ORGANIZATION_REPORTER", "ORGANIZATION_SCOPE", "ORGANIZATION_USER", "ORGANIZATION_GROUP",
+	"ORGANIZATION_ROLE", "ORGANIZATION_TAG", "ORGANIZATION_ENTRYPOINT", "ORGANIZATION_FORM",
+	"ORGANIZATION_MEMBER", "ENVIRONMENT", "DOMAIN", "DOMAIN_SETTINGS", "DOMAIN_FORM",
+	"DOMAIN_EMAIL_TEMPLATE", "DOMAIN_EXTENSION_POINT", "DOMAIN_IDENTITY_PROVIDER", "DOMAIN_AUDIT",
+	"DOMAIN_CERTIFICATE", "DOMAIN_USER", "DOMAIN_USER_DEVICE", "DOMAIN_GROUP", "DOMAIN_ROLE",
+	"DOMAIN_SCIM", "DOMAIN_SCOPE", "DOMAIN_EXTENSION_GRANT", "DOMAIN_REPORTER", "DOMAIN_MEMBER",
+	"DOMAIN_ANALYTICS", "DOMAIN_FACTOR", "DOMAIN_RESOURCE", "DOMAIN_FLOW", "DOMAIN_ALERT",
+	"DOMAIN_ALERT_NOTIFIER", "DOMAIN_BOT_DETECTION", "DOMAIN_DEVICE_IDENTIFIER", "DOMAIN_AUTHDEVICE_NOTIFIER",
+	"DOMAIN_UMA", "DOMAIN_UMA_SCOPE", "DOMAIN_OPENID", "DOMAIN_SAML", "DOMAIN_THEME",
+	"APPLICATION", "APPLICATION_SAML", "APPLICATION_SETTINGS", "APPLICATION_IDENTITY_PROVIDER",
+	"APPLICATION_FORM", "APPLICATION_EMAIL_TEMPLATE", "APPLICATION_OPENID", "APPLICATION_CERTIFICATE",
+	"APPLICATION_MEMBER", "APPLICATION_FACTOR", "APPLICATION_RESOURCE", "APPLICATION_ANALYTICS",
+	"APPLICATION_FLOW", "INSTALLATION",
+}
+
+// Acls lists the operations a permission can grant.
+var Acls = []string{"CREATE", "READ", "LIST", "UPDATE", "DELETE"}
+
+type RoleResourceModel struct {
+	Id             types.String `tfsdk:"id"`
+	ReferenceType  types.String `tfsdk:"reference_type"`
+	OrganizationId types.String `tfsdk:"organization_id"`
+	EnvironmentId  types.String `tfsdk:"environment_id"`
+	DomainId       types.String `tfsdk:"domain_id"`
+	RoleId         types.String `tfsdk:"role_id"`
+	Name           types.String `tfsdk:"name"`
+	Description    types.String `tfsdk:"description"`
+	AssignableType types.String `tfsdk:"assignable_type"`
+	Permissions    types.Map    `tfsdk:"permissions"`
+	System         types.Bool   `tfsdk:"system"`
+}
+
+func NewRoleFromResource(source RoleResourceModel) client.NewRole {
+	target := client.NewRole{
+		Name:        source.Name.ValueString(),
+		Description: convert.OptionalString(source.Description),
+	}
+	if assignableType := convert.OptionalString(source.AssignableType); assignableType != nil {
+		apiAssignableType := client.NewRoleAssignableType(*assignableType)
+		target.AssignableType = &apiAssignableType
+	}
+	return target
+}
+
+// UpdateRoleFromResource returns the full update of a role, permissions
+// removed from the configuration are revoked.
+func UpdateRoleFromResource(ctx context.Context, source RoleResourceModel) (client.UpdateRole, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	permissions := []string{}
+	if !source.Permissions.IsNull() && !source.Permissions.IsUnknown() {
+		var acls map[string][]string
+		diags.Append(source.Permissions.ElementsAs(ctx, &acls, false)...)
+		permissions = FlattenPermissions(acls)
+	}
+	return client.UpdateRole{
+		Name:        source.Name.ValueString(),
+		Description: convert.OptionalString(source.Description),
+		Permissions: &permissions,
+	}, diags
+}
+
+// FlattenPermissions converts a permission map to the `permission_acl` form
+// of the management API, such as `application_read`.
+func FlattenPermissions(source map[string][]string) []string {
+	target := []string{}
+	for permission, acls := range source {
+		for _, acl := range acls {
+			target = append(target, strings.ToLower(permission+"_"+acl))
+		}
+	}
+	sort.Strings(target)
+	return target
+}
+
+// UnflattenPermissions converts permissions in the `permission_acl` form of
+// the management API to a permission map.
+func UnflattenPermissions(source []string) map[string][]string {
+	target := map[string][]string{}
+	for _, permission := range source {
+		separator := strings.LastIndex(permission, "_")
+		if separator <= 0 {
+			continue
+		}
+		name := strings.ToUpper(permission[:separator])
+		target[name] = append(target[name], strings.ToUpper(permission[separator+1:]))
+	}
+	return target
+}
+
+func MapRoleResource(source *client.RoleEntity, target RoleResourceModel) RoleResourceModel {
+	target.RoleId = convert.String(source.Id)
+	if target.ReferenceType.ValueString() == "domain" {
+		target.Id = types.StringValue(target.OrganizationId.ValueString() + ":" + target.EnvironmentId.ValueString() + ":" + target.DomainId.ValueString() + ":" + target.RoleId.ValueString())
+	} else {
+		target.Id = types.StringValue(target.OrganizationId.ValueString() + ":" + target.RoleId.ValueString())
+	}
+	target.Name = convert.String(source.Name)
+	if (source.Description != nil && *source.Description != "") || !target.Description.IsNull() {
+		target.Description = convert.String(source.Description)
+	}
+	target.AssignableType = convert.String(source.AssignableType)
+	target.System = types.BoolValue(source.System != nil && *source.System)
+
+	// No permissions are returned when none are granted, they are kept null
+	// unless configured.
+	if (source.Permissions != nil && len(*source.Permissions) > 0) || !target.Permissions.IsNull() {
+		permissions := map[string]attr.Value{}
+		if source.Permissions != nil {
+			for permission, acls := range UnflattenPermissions(*source.Permissions) {
+				acls := acls
+				permissions[permission] = convert.StringSet(&acls)
+			}
+		}
+		target.Permissions = types.MapValueMust(types.SetType{ElemType: types.StringType}, permissions)
+	}
+	return target
+}
+
+func GetRoleResourceSchema() *schema.Schema {
+	return &schema.Schema{
+		MarkdownDescription: "Role resource, a set of permissions of a security domain or of an organization",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "TF identifier in the form organizationId:environmentId:domainId:roleId for domain roles and organizationId:roleId for organization roles",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"reference_type": schema.StringAttribute{
+				MarkdownDescription: "Role reference type, one of `domain` or `organization`",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.OneOf("domain", "organization"),
+				},
+			},
+			"organization_id": schema.StringAttribute{
+				MarkdownDescription: "Organization id",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"environment_id": schema.StringAttribute{
+				MarkdownDescription: "Environment id, required for domain roles",
+				Optional:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"domain_id": schema.StringAttribute{
+				MarkdownDescription: "Domain id, required for domain roles",
+				Optional:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"role_id": schema.StringAttribute{
+				MarkdownDescription: "Role id",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Role name",
+				Required:            true,
+			},
+			"description": schema.StringAttribute{
+				MarkdownDescription: "Role description",
+				Optional:            true,
+			},
+			"assignable_type": schema.StringAttribute{
+				MarkdownDescription: "Role assignable type, the kind of resource the role is granted on, one of `ORGANIZATION`, `ENVIRONMENT`, `DOMAIN` or `APPLICATION`",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.OneOf("ORGANIZATION", "ENVIRONMENT", "DOMAIN", "APPLICATION"),
+				},
+			},
+			"permissions": schema.MapAttribute{
+				MarkdownDescription: "Role permissions, from permission such as `APPLICATION` to the granted operations among `CREATE`, `READ`, `LIST`, `UPDATE` and `DELETE`",
+				ElementType:         types.SetType{ElemType: types.StringType},
+				Optional:            true,
+				Validators: []validator.Map{
+					mapvalidator.KeysAre(stringvalidator.OneOf(Permissions...)),
+					mapvalidator.ValueSetsAre(setvalidator.SizeAtLeast(1), setvalidator.ValueStringsAre(stringvalidator.OneOf(Acls...))),
+				},
+			},
+			"system": schema.BoolAttribute{
+				MarkdownDescription: "Role provided by the platform",
+				Computed:            true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
@@ -0,0 +1,96 @@
+package role
+
+import (
+	"context"
+	"encoding/json"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/thornleyk/graviteeioam-service/client"
+)
+
+func testRoleResourceModel() RoleResourceModel {
+	return RoleResourceModel{
+		ReferenceType:  types.StringValue("domain"),
+		OrganizationId: types.StringValue("DEFAULT"),
+		EnvironmentId:  types.StringValue("DEFAULT"),
+		DomainId:       types.StringValue("domain"),
+		Name:           types.StringValue("Application owner"),
+		Description:    types.StringNull(),
+		AssignableType: types.StringValue("APPLICATION"),
+		Permissions: types.MapValueMust(types.SetType{ElemType: types.StringType}, map[string]attr.Value{
+			"APPLICATION":        types.SetValueMust(types.StringType, []attr.Value{types.StringValue("READ"), types.StringValue("UPDATE")}),
+			"APPLICATION_OPENID": types.SetValueMust(types.StringType, []attr.Value{types.StringValue("READ")}),
+		}),
+		System: types.BoolUnknown(),
+	}
+}
+
+func TestFlattenPermissions(t *testing.T) {
+	permissions := map[string][]string{
+		"APPLICATION":        {"UPDATE", "READ"},
+		"APPLICATION_OPENID": {"READ"},
+	}
+
+	flattened := FlattenPermissions(permissions)
+	expected := []string{"application_openid_read", "application_read", "application_update"}
+	if !reflect.DeepEqual(flattened, expected) {
+		t.Fatalf("unexpected permissions: %v", flattened)
+	}
+
+	unflattened := UnflattenPermissions(flattened)
+	if !reflect.DeepEqual(unflattened, map[string][]string{"APPLICATION": {"READ", "UPDATE"}, "APPLICATION_OPENID": {"READ"}}) {
+		t.Errorf("unexpected permission map: %v", unflattened)
+	}
+}
+
+func TestUpdateRoleFromResource(t *testing.T) {
+	update, diags := UpdateRoleFromResource(context.Background(), testRoleResourceModel())
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+	if update.Name != "Application owner" || update.Description != nil || len(*update.Permissions) != 3 {
+		t.Errorf("unexpected update: %+v", update)
+	}
+
+	source := testRoleResourceModel()
+	source.Permissions = types.MapNull(types.SetType{ElemType: types.StringType})
+	update, _ = UpdateRoleFromResource(context.Background(), source)
+	if update.Permissions == nil || len(*update.Permissions) != 0 {
+		t.Errorf("expected unset permissions to be revoked, got %v", update.Permissions)
+	}
+}
+
+func TestMapRoleResource(t *testing.T) {
+	var source client.RoleEntity
+	payload := `{
+		"id": "role",
+		"name": "Application owner",
+		"description": "",
+		"assignableType": "APPLICATION",
+		"system": false,
+		"permissions": ["application_read", "application_update", "application_openid_read"]
+	}`
+	if err := json.Unmarshal([]byte(payload), &source); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	target := MapRoleResource(&source, testRoleResourceModel())
+	if target.Id.ValueString() != "DEFAULT:DEFAULT:domain:role" || target.RoleId.ValueString() != "role" || !target.Description.IsNull() {
+		t.Errorf("unexpected role: %+v", target)
+	}
+	if !target.Permissions.Equal(testRoleResourceModel().Permissions) {
+		t.Errorf("unexpected permissions: %v", target.Permissions)
+	}
+
+	organizationRole := testRoleResourceModel()
+	organizationRole.ReferenceType = types.StringValue("organization")
+	organizationRole.Permissions = types.MapNull(types.SetType{ElemType: types.StringType})
+	source.Permissions = nil
+	target = MapRoleResource(&source, organizationRole)
+	if target.Id.ValueString() != "DEFAULT:role" || !target.Permissions.IsNull() {
+		t.Errorf("unexpected organization role: %+v", target)
+	}
+}
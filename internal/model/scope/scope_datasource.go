@@ -0,0 +1,120 @@
+package scope
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/thornleyk/graviteeioam-service/client"
+	"github.com/thornleyk/terraform-provider-graviteeioam/internal/model/convert"
+)
+
+type ScopeLightDataSourceModel struct {
+	ScopeId       types.String `tfsdk:"scope_id"`
+	Key           types.String `tfsdk:"key"`
+	Name          types.String `tfsdk:"name"`
+	Description   types.String `tfsdk:"description"`
+	Discovery     types.Bool   `tfsdk:"discovery"`
+	Parameterized types.Bool   `tfsdk:"parameterized"`
+	System        types.Bool   `tfsdk:"system"`
+}
+
+type ScopesDataSourceModel struct {
+	Id             types.String                `tfsdk:"id"`
+	OrganizationId types.String                `tfsdk:"organization_id"`
+	EnvironmentId  types.String                `tfsdk:"environment_id"`
+	DomainId       types.String                `tfsdk:"domain_id"`
+	Query          types.String                `tfsdk:"query"`
+	Keys           types.List                  `tfsdk:"keys"`
+	Scopes         []ScopeLightDataSourceModel `tfsdk:"scopes"`
+}
+
+func MapScopesDataSource(source []client.Scope, target ScopesDataSourceModel) (ScopesDataSourceModel, error) {
+	target.Id = types.StringValue(target.OrganizationId.ValueString() + ":" + target.EnvironmentId.ValueString() + ":" + target.DomainId.ValueString())
+	keys := []string{}
+	target.Scopes = []ScopeLightDataSourceModel{}
+	for _, scope := range source {
+		if scope.Key != nil {
+			keys = append(keys, *scope.Key)
+		}
+		target.Scopes = append(target.Scopes, ScopeLightDataSourceModel{
+			ScopeId:       convert.String(scope.Id),
+			Key:           convert.String(scope.Key),
+			Name:          convert.String(scope.Name),
+			Description:   convert.String(scope.Description),
+			Discovery:     convert.Bool(scope.Discovery),
+			Parameterized: convert.Bool(scope.Parameterized),
+			System:        convert.Bool(scope.System),
+		})
+	}
+	target.Keys = convert.StringList(&keys)
+	return target, nil
+}
+
+func GetScopesDataSourceSchema() *schema.Schema {
+	return &schema.Schema{
+		MarkdownDescription: "Scopes data source, the OAuth 2.0 scopes of a security domain",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "TF identifier in the form organizationId:environmentId:domainId",
+				Computed:            true,
+			},
+			"organization_id": schema.StringAttribute{
+				MarkdownDescription: "Organization id",
+				Required:            true,
+			},
+			"environment_id": schema.StringAttribute{
+				MarkdownDescription: "Environment id",
+				Required:            true,
+			},
+			"domain_id": schema.StringAttribute{
+				MarkdownDescription: "Domain id",
+				Required:            true,
+			},
+			"query": schema.StringAttribute{
+				MarkdownDescription: "Search query on the scope key and name, every scope is listed when unset",
+				Optional:            true,
+			},
+			"keys": schema.ListAttribute{
+				MarkdownDescription: "Scope keys",
+				ElementType:         types.StringType,
+				Computed:            true,
+			},
+			"scopes": schema.ListNestedAttribute{
+				MarkdownDescription: "Domain scopes",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"scope_id": schema.StringAttribute{
+							MarkdownDescription: "Scope id",
+							Computed:            true,
+						},
+						"key": schema.StringAttribute{
+							MarkdownDescription: "Scope key",
+							Computed:            true,
+						},
+						"name": schema.StringAttribute{
+							MarkdownDescription: "Scope name",
+							Computed:            true,
+						},
+						"description": schema.StringAttribute{
+							MarkdownDescription: "Scope description",
+							Computed:            true,
+						},
+						"discovery": schema.BoolAttribute{
+							MarkdownDescription: "Scope listed in the OpenID Connect discovery document",
+							Computed:            true,
+						},
+						"parameterized": schema.BoolAttribute{
+							MarkdownDescription: "Scope accepting a parameter",
+							Computed:            true,
+						},
+						"system": schema.BoolAttribute{
+							MarkdownDescription: "Scope provided by the platform",
+							Computed:            true,
+						},
+					},
+				},
+				Computed: true,
+			},
+		},
+	}
+}
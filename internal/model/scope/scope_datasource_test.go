@@ -0,0 +1,42 @@
+package scope
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/thornleyk/graviteeioam-service/client"
+)
+
+func TestMapScopesDataSource(t *testing.T) {
+	var source []client.Scope
+	payload := `[
+		{"id": "openid", "key": "openid", "name": "OpenID", "system": true, "discovery": true},
+		{"id": "orders", "key": "orders:read", "name": "Read orders"}
+	]`
+	if err := json.Unmarshal([]byte(payload), &source); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	target, err := MapScopesDataSource(source, ScopesDataSourceModel{
+		OrganizationId: types.StringValue("DEFAULT"),
+		EnvironmentId:  types.StringValue("DEFAULT"),
+		DomainId:       types.StringValue("domain"),
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if target.Id.ValueString() != "DEFAULT:DEFAULT:domain" || len(target.Scopes) != 2 {
+		t.Fatalf("unexpected scopes: %+v", target)
+	}
+	if !target.Keys.Equal(types.ListValueMust(types.StringType, []attr.Value{types.StringValue("openid"), types.StringValue("orders:read")})) {
+		t.Errorf("unexpected keys: %v", target.Keys)
+	}
+	if scope := target.Scopes[0]; !scope.System.ValueBool() || !scope.Discovery.ValueBool() {
+		t.Errorf("unexpected scope: %+v", scope)
+	}
+	if scope := target.Scopes[1]; !scope.System.IsNull() || !scope.Description.IsNull() {
+		t.Errorf("expected missing fields to be null, got %+v", scope)
+	}
+}
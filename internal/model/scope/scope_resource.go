@@ -0,0 +1,191 @@
+package scope
+
+import (
+	"context"
+	"regexp"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/thornleyk/graviteeioam-service/client"
+	"github.com/thornleyk/terraform-provider-graviteeioam/internal/model/convert"
+)
+
+// UpdateScope is the full update of a scope, the generated client does not
+// declare the claims accepted by the management API.
+type UpdateScope struct {
+	client.UpdateScope
+	Claims *[]string `json:"claims,omitempty"`
+}
+
+type ScopeResourceModel struct {
+	Id             types.String `tfsdk:"id"`
+	OrganizationId types.String `tfsdk:"organization_id"`
+	EnvironmentId  types.String `tfsdk:"environment_id"`
+	DomainId       types.String `tfsdk:"domain_id"`
+	ScopeId        types.String `tfsdk:"scope_id"`
+	Key            types.String `tfsdk:"key"`
+	Name           types.String `tfsdk:"name"`
+	Description    types.String `tfsdk:"description"`
+	ExpiresIn      types.Int64  `tfsdk:"expires_in"`
+	Discovery      types.Bool   `tfsdk:"discovery"`
+	Parameterized  types.Bool   `tfsdk:"parameterized"`
+	Claims         types.Set    `tfsdk:"claims"`
+	IconURI        types.String `tfsdk:"icon_uri"`
+	System         types.Bool   `tfsdk:"system"`
+}
+
+func NewScopeFromResource(source ScopeResourceModel) client.NewScope {
+	return client.NewScope{
+		Key:           source.Key.ValueString(),
+		Name:          source.Name.ValueString(),
+		Description:   source.Description.ValueString(),
+		ExpiresIn:     convert.OptionalInt32(source.ExpiresIn),
+		Discovery:     convert.OptionalBool(source.Discovery),
+		Parameterized: convert.OptionalBool(source.Parameterized),
+		IconUri:       convert.OptionalString(source.IconURI),
+	}
+}
+
+// UpdateScopeFromResource returns the full update of a scope, claims removed
+// from the configuration are cleared.
+func UpdateScopeFromResource(ctx context.Context, source ScopeResourceModel) (UpdateScope, diag.Diagnostics) {
+	claims, diags := convert.OptionalStrings(ctx, source.Claims)
+	if claims == nil {
+		claims = &[]string{}
+	}
+	return UpdateScope{
+		UpdateScope: client.UpdateScope{
+			Name:          source.Name.ValueString(),
+			Description:   source.Description.ValueString(),
+			ExpiresIn:     convert.OptionalInt32(source.ExpiresIn),
+			Discovery:     convert.OptionalBool(source.Discovery),
+			Parameterized: convert.OptionalBool(source.Parameterized),
+			IconUri:       convert.OptionalString(source.IconURI),
+		},
+		Claims: claims,
+	}, diags
+}
+
+func MapScopeResource(source *client.Scope, target ScopeResourceModel) ScopeResourceModel {
+	target.ScopeId = convert.String(source.Id)
+	target.Id = types.StringValue(target.OrganizationId.ValueString() + ":" + target.EnvironmentId.ValueString() + ":" + target.DomainId.ValueString() + ":" + target.ScopeId.ValueString())
+	target.Key = convert.String(source.Key)
+	target.Name = convert.String(source.Name)
+	if (source.Description != nil && *source.Description != "") || !target.Description.IsNull() {
+		target.Description = convert.String(source.Description)
+	}
+	target.ExpiresIn = convert.Int32(source.ExpiresIn)
+	target.Discovery = types.BoolValue(source.Discovery != nil && *source.Discovery)
+	target.Parameterized = types.BoolValue(source.Parameterized != nil && *source.Parameterized)
+	if (source.Claims != nil && len(*source.Claims) > 0) || !target.Claims.IsNull() {
+		claims := []string{}
+		if source.Claims != nil {
+			claims = *source.Claims
+		}
+		target.Claims = convert.StringSet(&claims)
+	}
+	target.IconURI = convert.String(source.IconUri)
+	target.System = types.BoolValue(source.System != nil && *source.System)
+	return target
+}
+
+func GetScopeResourceSchema() *schema.Schema {
+	return &schema.Schema{
+		MarkdownDescription: "Scope resource, an OAuth 2.0 scope of a security domain",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "TF identifier in the form organizationId:environmentId:domainId:scopeId",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"organization_id": schema.StringAttribute{
+				MarkdownDescription: "Organization id",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"environment_id": schema.StringAttribute{
+				MarkdownDescription: "Environment id",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"domain_id": schema.StringAttribute{
+				MarkdownDescription: "Domain id",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"scope_id": schema.StringAttribute{
+				MarkdownDescription: "Scope id",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"key": schema.StringAttribute{
+				MarkdownDescription: "Scope key, the value requested by the clients",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(regexp.MustCompile(`^[^\sA-Z]+$`), "must be lower case and must not contain whitespace"),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Scope name",
+				Required:            true,
+			},
+			"description": schema.StringAttribute{
+				MarkdownDescription: "Scope description, shown on the consent page",
+				Optional:            true,
+			},
+			"expires_in": schema.Int64Attribute{
+				MarkdownDescription: "Scope consent lifetime in seconds, unset for no expiry",
+				Optional:            true,
+			},
+			"discovery": schema.BoolAttribute{
+				MarkdownDescription: "Scope listed in the OpenID Connect discovery document",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"parameterized": schema.BoolAttribute{
+				MarkdownDescription: "Scope accepting a parameter, requested as `key:value`",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"claims": schema.SetAttribute{
+				MarkdownDescription: "Scope claims, the user claims released when the scope is granted",
+				ElementType:         types.StringType,
+				Optional:            true,
+			},
+			"icon_uri": schema.StringAttribute{
+				MarkdownDescription: "Scope icon URI, shown on the consent page",
+				Optional:            true,
+			},
+			"system": schema.BoolAttribute{
+				MarkdownDescription: "Scope provided by the platform",
+				Computed:            true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
@@ -0,0 +1,76 @@
+package scope
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/thornleyk/graviteeioam-service/client"
+)
+
+func testScopeResourceModel() ScopeResourceModel {
+	return ScopeResourceModel{
+		OrganizationId: types.StringValue("DEFAULT"),
+		EnvironmentId:  types.StringValue("DEFAULT"),
+		DomainId:       types.StringValue("domain"),
+		Key:            types.StringValue("orders:read"),
+		Name:           types.StringValue("Read orders"),
+		Description:    types.StringNull(),
+		ExpiresIn:      types.Int64Value(3600),
+		Discovery:      types.BoolValue(true),
+		Parameterized:  types.BoolValue(false),
+		Claims:         types.SetNull(types.StringType),
+		IconURI:        types.StringNull(),
+		System:         types.BoolUnknown(),
+	}
+}
+
+func TestUpdateScopeFromResourceClearsClaims(t *testing.T) {
+	update, diags := UpdateScopeFromResource(context.Background(), testScopeResourceModel())
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+
+	body, err := json.Marshal(update)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	var decoded map[string]interface{}
+	if err := json.Unmarshal(body, &decoded); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if decoded["name"] != "Read orders" || decoded["description"] != "" || decoded["expiresIn"] != float64(3600) || decoded["discovery"] != true {
+		t.Errorf("unexpected update: %s", body)
+	}
+	if claims, ok := decoded["claims"].([]interface{}); !ok || len(claims) != 0 {
+		t.Errorf("expected unset claims to be cleared, got %s", body)
+	}
+}
+
+func TestMapScopeResource(t *testing.T) {
+	var source client.Scope
+	payload := `{
+		"id": "scope",
+		"key": "orders:read",
+		"name": "Read orders",
+		"description": "",
+		"discovery": true,
+		"claims": ["email", "name"]
+	}`
+	if err := json.Unmarshal([]byte(payload), &source); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	target := MapScopeResource(&source, testScopeResourceModel())
+	if target.Id.ValueString() != "DEFAULT:DEFAULT:domain:scope" || target.Key.ValueString() != "orders:read" || !target.Description.IsNull() {
+		t.Errorf("unexpected scope: %+v", target)
+	}
+	if !target.ExpiresIn.IsNull() || !target.Discovery.ValueBool() || target.Parameterized.ValueBool() || target.System.ValueBool() {
+		t.Errorf("unexpected scope settings: %+v", target)
+	}
+	if !target.Claims.Equal(types.SetValueMust(types.StringType, []attr.Value{types.StringValue("email"), types.StringValue("name")})) {
+		t.Errorf("unexpected claims: %v", target.Claims)
+	}
+}
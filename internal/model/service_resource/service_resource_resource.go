@@ -0,0 +1,214 @@
+package service_resource
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/thornleyk/graviteeioam-service/client"
+	"github.com/thornleyk/terraform-provider-graviteeioam/internal/model/convert"
+)
+
+var configurationPath = path.Root("configuration")
+
+type ServiceResourceResourceModel struct {
+	Id                         types.String `tfsdk:"id"`
+	OrganizationId             types.String `tfsdk:"organization_id"`
+	EnvironmentId              types.String `tfsdk:"environment_id"`
+	DomainId                   types.String `tfsdk:"domain_id"`
+	ResourceId                 types.String `tfsdk:"resource_id"`
+	Name                       types.String `tfsdk:"name"`
+	Type                       types.String `tfsdk:"type"`
+	Configuration              types.String `tfsdk:"configuration"`
+	SensitiveConfiguration     types.Map    `tfsdk:"sensitive_configuration"`
+	SensitiveConfigurationHash types.String `tfsdk:"sensitive_configuration_hash"`
+}
+
+// Configuration returns the plugin configuration of a service resource, the
+// configuration with the sensitive keys merged in.
+func Configuration(ctx context.Context, source ServiceResourceResourceModel) (string, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	configuration := map[string]interface{}{}
+	if err := json.Unmarshal([]byte(source.Configuration.ValueString()), &configuration); err != nil {
+		diags.AddAttributeError(configurationPath, "Invalid configuration", fmt.Sprintf("The configuration must be a JSON object: %s", err))
+		return "", diags
+	}
+
+	sensitive, valueDiags := convert.OptionalStringMap(ctx, source.SensitiveConfiguration)
+	diags.Append(valueDiags...)
+	if sensitive != nil {
+		for key, value := range *sensitive {
+			configuration[key] = value
+		}
+	}
+
+	encoded, err := json.Marshal(configuration)
+	if err != nil {
+		diags.AddAttributeError(configurationPath, "Invalid configuration", err.Error())
+		return "", diags
+	}
+	return string(encoded), diags
+}
+
+func NewServiceResourceFromResource(ctx context.Context, source ServiceResourceResourceModel) (client.NewServiceResource, diag.Diagnostics) {
+	configuration, diags := Configuration(ctx, source)
+	return client.NewServiceResource{
+		Name:          source.Name.ValueString(),
+		Type:          source.Type.ValueString(),
+		Configuration: configuration,
+	}, diags
+}
+
+func UpdateServiceResourceFromResource(ctx context.Context, source ServiceResourceResourceModel) (client.UpdateServiceResource, diag.Diagnostics) {
+	configuration, diags := Configuration(ctx, source)
+	return client.UpdateServiceResource{
+		Name:          source.Name.ValueString(),
+		Configuration: configuration,
+	}, diags
+}
+
+// SensitiveConfigurationHash returns the SHA-256 hash of the sensitive keys
+// of a configuration, null when there are none.
+func SensitiveConfigurationHash(ctx context.Context, source types.Map) (types.String, diag.Diagnostics) {
+	if source.IsUnknown() {
+		return types.StringUnknown(), nil
+	}
+	sensitive, diags := convert.OptionalStringMap(ctx, source)
+	if sensitive == nil {
+		return types.StringNull(), diags
+	}
+	return types.StringValue(hash(*sensitive)), diags
+}
+
+func hash(values map[string]string) string {
+	// Maps are encoded with sorted keys.
+	encoded, _ := json.Marshal(values)
+	sum := sha256.Sum256(encoded)
+	return hex.EncodeToString(sum[:])
+}
+
+// MapServiceResource maps a service resource. The sensitive keys are split
+// from the configuration and only their hash is compared, the values masked
+// by AM are assumed unchanged and the keys missing from AM show as drift.
+func MapServiceResource(ctx context.Context, source *client.ServiceResource, target ServiceResourceResourceModel) (ServiceResourceResourceModel, diag.Diagnostics) {
+	target.ResourceId = convert.String(source.Id)
+	target.Id = types.StringValue(target.OrganizationId.ValueString() + ":" + target.EnvironmentId.ValueString() + ":" + target.DomainId.ValueString() + ":" + target.ResourceId.ValueString())
+	target.Name = convert.String(source.Name)
+	target.Type = convert.String(source.Type)
+
+	sensitive, diags := convert.OptionalStringMap(ctx, target.SensitiveConfiguration)
+	if diags.HasError() {
+		return target, diags
+	}
+
+	configuration := map[string]interface{}{}
+	if source.Configuration == nil || json.Unmarshal([]byte(*source.Configuration), &configuration) != nil {
+		target.Configuration = convert.JSON(source.Configuration, target.Configuration)
+		return target, diags
+	}
+
+	if sensitive != nil {
+		current := map[string]string{}
+		for key, value := range *sensitive {
+			serverValue, ok := configuration[key]
+			if !ok {
+				continue
+			}
+			current[key] = value
+			if s, isString := serverValue.(string); !isString || s != convert.SensitiveValue {
+				current[key] = fmt.Sprint(serverValue)
+			}
+			delete(configuration, key)
+		}
+		target.SensitiveConfigurationHash = types.StringValue(hash(current))
+	} else {
+		target.SensitiveConfigurationHash = types.StringNull()
+	}
+
+	encoded, err := json.Marshal(configuration)
+	if err != nil {
+		target.Configuration = convert.JSON(source.Configuration, target.Configuration)
+		return target, diags
+	}
+	remaining := string(encoded)
+	target.Configuration = convert.JSON(convert.UnmaskedJSON(&remaining, target.Configuration), target.Configuration)
+	return target, diags
+}
+
+func GetServiceResourceResourceSchema() *schema.Schema {
+	return &schema.Schema{
+		MarkdownDescription: "Service resource, a service used by the factors and emails of a security domain such as an SMTP server or a Twilio verify service",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "TF identifier in the form organizationId:environmentId:domainId:resourceId",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"organization_id": schema.StringAttribute{
+				MarkdownDescription: "Organization id",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"environment_id": schema.StringAttribute{
+				MarkdownDescription: "Environment id",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"domain_id": schema.StringAttribute{
+				MarkdownDescription: "Domain id",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"resource_id": schema.StringAttribute{
+				MarkdownDescription: "Service resource id",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Service resource name",
+				Required:            true,
+			},
+			"type": schema.StringAttribute{
+				MarkdownDescription: "Service resource type, the resource plugin id such as `smtp-am-resource`, `twilio-verify-am-resource` or `http-factor-am-resource`",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"configuration": schema.StringAttribute{
+				MarkdownDescription: "Service resource configuration, a JSON object matching the plugin schema without its sensitive keys",
+				Required:            true,
+			},
+			"sensitive_configuration": schema.MapAttribute{
+				MarkdownDescription: "Sensitive keys of the configuration, such as passwords and API tokens, merged into `configuration`. They are kept out of the plan output and their changes in AM are detected through `sensitive_configuration_hash`. The values masked by AM cannot be compared, only the unmasked values and the removed keys are detected",
+				ElementType:         types.StringType,
+				Optional:            true,
+				Sensitive:           true,
+			},
+			"sensitive_configuration_hash": schema.StringAttribute{
+				MarkdownDescription: "SHA-256 hash of the sensitive keys of the configuration",
+				Computed:            true,
+				Sensitive:           true,
+			},
+		},
+	}
+}
@@ -0,0 +1,99 @@
+package service_resource
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/thornleyk/graviteeioam-service/client"
+)
+
+func testServiceResourceResourceModel() ServiceResourceResourceModel {
+	return ServiceResourceResourceModel{
+		OrganizationId: types.StringValue("DEFAULT"),
+		EnvironmentId:  types.StringValue("DEFAULT"),
+		DomainId:       types.StringValue("domain"),
+		Name:           types.StringValue("SMTP"),
+		Type:           types.StringValue("smtp-am-resource"),
+		Configuration:  types.StringValue(`{"host": "smtp.example.com", "port": 587, "username": "mailer"}`),
+		SensitiveConfiguration: types.MapValueMust(types.StringType, map[string]attr.Value{
+			"password": types.StringValue("secret"),
+		}),
+	}
+}
+
+func TestNewServiceResourceFromResourceMergesSensitiveConfiguration(t *testing.T) {
+	payload, diags := NewServiceResourceFromResource(context.Background(), testServiceResourceResourceModel())
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+	var configuration map[string]interface{}
+	if err := json.Unmarshal([]byte(payload.Configuration), &configuration); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if configuration["password"] != "secret" || configuration["host"] != "smtp.example.com" {
+		t.Errorf("unexpected configuration: %s", payload.Configuration)
+	}
+
+	data := testServiceResourceResourceModel()
+	data.Configuration = types.StringValue(`["not", "an", "object"]`)
+	if _, diags := UpdateServiceResourceFromResource(context.Background(), data); !diags.HasError() {
+		t.Error("expected a configuration that is not an object to be rejected")
+	}
+}
+
+func TestMapServiceResourceHashesSensitiveConfiguration(t *testing.T) {
+	ctx := context.Background()
+	data := testServiceResourceResourceModel()
+	planned, _ := SensitiveConfigurationHash(ctx, data.SensitiveConfiguration)
+
+	var source client.ServiceResource
+	payload := `{
+		"id": "resource",
+		"name": "SMTP",
+		"type": "smtp-am-resource",
+		"configuration": "{\"host\":\"smtp.example.com\",\"port\":587,\"username\":\"mailer\",\"password\":\"********\"}"
+	}`
+	if err := json.Unmarshal([]byte(payload), &source); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	target, diags := MapServiceResource(ctx, &source, data)
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+	if target.Id.ValueString() != "DEFAULT:DEFAULT:domain:resource" {
+		t.Errorf("unexpected id: %s", target.Id)
+	}
+	if target.Configuration != data.Configuration {
+		t.Errorf("expected the sensitive keys to be split from the configuration, got %s", target.Configuration)
+	}
+	if target.SensitiveConfigurationHash != planned {
+		t.Errorf("expected a masked value to match the planned hash, got %s", target.SensitiveConfigurationHash)
+	}
+
+	changed := `{"host":"smtp.example.com","port":587,"username":"mailer","password":"changed"}`
+	source.Configuration = &changed
+	target, _ = MapServiceResource(ctx, &source, data)
+	if target.SensitiveConfigurationHash == planned {
+		t.Error("expected a changed sensitive value to change the hash")
+	}
+
+	missing := `{"host":"smtp.example.com","port":587,"username":"mailer"}`
+	source.Configuration = &missing
+	target, _ = MapServiceResource(ctx, &source, data)
+	if target.SensitiveConfigurationHash == planned {
+		t.Error("expected a sensitive key missing from AM to change the hash")
+	}
+
+	data.SensitiveConfiguration = types.MapNull(types.StringType)
+	if hash, _ := SensitiveConfigurationHash(ctx, data.SensitiveConfiguration); !hash.IsNull() {
+		t.Errorf("expected no hash without sensitive configuration, got %s", hash)
+	}
+	target, _ = MapServiceResource(ctx, &source, data)
+	if !target.SensitiveConfigurationHash.IsNull() {
+		t.Errorf("expected no hash without sensitive configuration, got %s", target.SensitiveConfigurationHash)
+	}
+}
@@ -0,0 +1,265 @@
+package user
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/thornleyk/graviteeioam-service/client"
+	"github.com/thornleyk/terraform-provider-graviteeioam/internal/model/convert"
+)
+
+// UserEntity is a user of the management API. The generated client declares
+// the additional information and the address as maps of objects whereas AM
+// returns maps of any JSON value.
+type UserEntity struct {
+	client.UserEntity
+	AdditionalInformation map[string]interface{} `json:"additionalInformation,omitempty"`
+	Address               map[string]interface{} `json:"address,omitempty"`
+}
+
+// NewUser is a new user, with additional information of any JSON value.
+type NewUser struct {
+	client.NewUser
+	AdditionalInformation map[string]interface{} `json:"additionalInformation,omitempty"`
+}
+
+// UpdateUser is the update of a user, with additional information of any
+// JSON value.
+type UpdateUser struct {
+	client.UpdateUser
+	AdditionalInformation map[string]interface{} `json:"additionalInformation,omitempty"`
+}
+
+type UserResourceModel struct {
+	Id                    types.String `tfsdk:"id"`
+	ReferenceType         types.String `tfsdk:"reference_type"`
+	OrganizationId        types.String `tfsdk:"organization_id"`
+	EnvironmentId         types.String `tfsdk:"environment_id"`
+	DomainId              types.String `tfsdk:"domain_id"`
+	UserId                types.String `tfsdk:"user_id"`
+	Username              types.String `tfsdk:"username"`
+	Email                 types.String `tfsdk:"email"`
+	FirstName             types.String `tfsdk:"first_name"`
+	LastName              types.String `tfsdk:"last_name"`
+	AdditionalInformation types.Map    `tfsdk:"additional_information"`
+	Enabled               types.Bool   `tfsdk:"enabled"`
+	Source                types.String `tfsdk:"source"`
+	Password              types.String `tfsdk:"password"`
+	ResetPasswordOnChange types.Bool   `tfsdk:"reset_password_on_change"`
+}
+
+// NewUserFromResource returns a new user. Users without a password are
+// pre-registered, they are sent a link to complete their registration.
+func NewUserFromResource(ctx context.Context, source UserResourceModel) (NewUser, diag.Diagnostics) {
+	additionalInformation, diags := convert.OptionalStringMap(ctx, source.AdditionalInformation)
+	target := NewUser{
+		NewUser: client.NewUser{
+			Username:  convert.OptionalString(source.Username),
+			Email:     convert.OptionalString(source.Email),
+			FirstName: convert.OptionalString(source.FirstName),
+			LastName:  convert.OptionalString(source.LastName),
+			Enabled:   convert.OptionalBool(source.Enabled),
+			Source:    convert.OptionalString(source.Source),
+			Password:  convert.OptionalString(source.Password),
+		},
+	}
+	if target.Password == nil {
+		preRegistration := true
+		target.PreRegistration = &preRegistration
+	}
+	if additionalInformation != nil {
+		target.AdditionalInformation = map[string]interface{}{}
+		for key, value := range *additionalInformation {
+			target.AdditionalInformation[key] = value
+		}
+	}
+	return target, diags
+}
+
+// UpdateUserFromResource returns the update of a user. The configured
+// additional information is merged into the current one, the keys removed
+// from the configuration are deleted and the others are left untouched.
+func UpdateUserFromResource(ctx context.Context, source UserResourceModel, prior UserResourceModel, current *UserEntity) (UpdateUser, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	configured, d := convert.OptionalStringMap(ctx, source.AdditionalInformation)
+	diags.Append(d...)
+	previous, d := convert.OptionalStringMap(ctx, prior.AdditionalInformation)
+	diags.Append(d...)
+
+	additionalInformation := map[string]interface{}{}
+	for key, value := range current.AdditionalInformation {
+		additionalInformation[key] = value
+	}
+	if previous != nil {
+		for key := range *previous {
+			delete(additionalInformation, key)
+		}
+	}
+	if configured != nil {
+		for key, value := range *configured {
+			additionalInformation[key] = value
+		}
+	}
+
+	return UpdateUser{
+		UpdateUser: client.UpdateUser{
+			Email:     convert.OptionalString(source.Email),
+			FirstName: convert.OptionalString(source.FirstName),
+			LastName:  convert.OptionalString(source.LastName),
+		},
+		AdditionalInformation: additionalInformation,
+	}, diags
+}
+
+// MapUserResource maps a user, the password is never returned and the
+// configured one is kept in state to detect its changes.
+func MapUserResource(source *UserEntity, target UserResourceModel) UserResourceModel {
+	target.UserId = convert.String(source.Id)
+	if target.ReferenceType.ValueString() == "domain" {
+		target.Id = types.StringValue(target.OrganizationId.ValueString() + ":" + target.EnvironmentId.ValueString() + ":" + target.DomainId.ValueString() + ":" + target.UserId.ValueString())
+	} else {
+		target.Id = types.StringValue(target.OrganizationId.ValueString() + ":" + target.UserId.ValueString())
+	}
+	target.Username = convert.String(source.Username)
+	target.Email = convert.String(source.Email)
+	target.FirstName = convert.String(source.FirstName)
+	target.LastName = convert.String(source.LastName)
+	target.Enabled = types.BoolValue(source.Enabled == nil || *source.Enabled)
+	target.Source = convert.String(source.Source)
+
+	// AM keeps its own claims in the additional information, only the
+	// configured keys are tracked.
+	if !target.AdditionalInformation.IsNull() && !target.AdditionalInformation.IsUnknown() {
+		additionalInformation := map[string]string{}
+		for key := range target.AdditionalInformation.Elements() {
+			if value, ok := source.AdditionalInformation[key]; ok {
+				additionalInformation[key] = stringValue(value)
+			}
+		}
+		target.AdditionalInformation = convert.StringMap(&additionalInformation)
+	}
+	return target
+}
+
+// stringValue returns a string value as is and any other JSON value
+// encoded.
+func stringValue(value interface{}) string {
+	if s, ok := value.(string); ok {
+		return s
+	}
+	encoded, err := json.Marshal(value)
+	if err != nil {
+		return fmt.Sprint(value)
+	}
+	return string(encoded)
+}
+
+func GetUserResourceSchema() *schema.Schema {
+	return &schema.Schema{
+		MarkdownDescription: "User resource, a user of a security domain or of an organization",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "TF identifier in the form organizationId:environmentId:domainId:userId for domain users and organizationId:userId for organization users",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"reference_type": schema.StringAttribute{
+				MarkdownDescription: "User reference type, one of `domain` or `organization`",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.OneOf("domain", "organization"),
+				},
+			},
+			"organization_id": schema.StringAttribute{
+				MarkdownDescription: "Organization id",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"environment_id": schema.StringAttribute{
+				MarkdownDescription: "Environment id, required for domain users",
+				Optional:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"domain_id": schema.StringAttribute{
+				MarkdownDescription: "Domain id, required for domain users",
+				Optional:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"user_id": schema.StringAttribute{
+				MarkdownDescription: "User id",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"username": schema.StringAttribute{
+				MarkdownDescription: "User name, used to log in",
+				Required:            true,
+			},
+			"email": schema.StringAttribute{
+				MarkdownDescription: "User email",
+				Optional:            true,
+			},
+			"first_name": schema.StringAttribute{
+				MarkdownDescription: "User first name",
+				Optional:            true,
+			},
+			"last_name": schema.StringAttribute{
+				MarkdownDescription: "User last name",
+				Optional:            true,
+			},
+			"additional_information": schema.MapAttribute{
+				MarkdownDescription: "User additional information, such as custom claims. Only the configured keys are managed, the other ones, such as the claims set by AM, are left untouched",
+				ElementType:         types.StringType,
+				Optional:            true,
+			},
+			"enabled": schema.BoolAttribute{
+				MarkdownDescription: "User allowed to log in",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(true),
+			},
+			"source": schema.StringAttribute{
+				MarkdownDescription: "Id of the identity provider storing the user, the default identity provider when unset",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"password": schema.StringAttribute{
+				MarkdownDescription: "User initial password. AM never returns it, the configured value is stored in clear text in the Terraform state, protect the state accordingly. Changes are only applied when `reset_password_on_change` is set. Users created without a password are pre-registered and sent a link to complete their registration",
+				Optional:            true,
+				Sensitive:           true,
+			},
+			"reset_password_on_change": schema.BoolAttribute{
+				MarkdownDescription: "Reset the password of the user when `password` changes, otherwise it is only used on creation",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+		},
+	}
+}
@@ -0,0 +1,107 @@
+package user
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func testUserResourceModel() UserResourceModel {
+	return UserResourceModel{
+		ReferenceType:  types.StringValue("domain"),
+		OrganizationId: types.StringValue("DEFAULT"),
+		EnvironmentId:  types.StringValue("DEFAULT"),
+		DomainId:       types.StringValue("domain"),
+		Username:       types.StringValue("jdoe"),
+		Email:          types.StringValue("jdoe@example.com"),
+		FirstName:      types.StringValue("John"),
+		LastName:       types.StringNull(),
+		AdditionalInformation: types.MapValueMust(types.StringType, map[string]attr.Value{
+			"team": types.StringValue("support"),
+		}),
+		Enabled:               types.BoolValue(true),
+		Source:                types.StringUnknown(),
+		Password:              types.StringNull(),
+		ResetPasswordOnChange: types.BoolValue(false),
+	}
+}
+
+func TestNewUserFromResource(t *testing.T) {
+	newUser, diags := NewUserFromResource(context.Background(), testUserResourceModel())
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+
+	body, err := json.Marshal(newUser)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	var decoded map[string]interface{}
+	if err := json.Unmarshal(body, &decoded); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if decoded["username"] != "jdoe" || decoded["preRegistration"] != true || decoded["password"] != nil || decoded["source"] != nil {
+		t.Errorf("unexpected new user: %s", body)
+	}
+	if additionalInformation, ok := decoded["additionalInformation"].(map[string]interface{}); !ok || additionalInformation["team"] != "support" {
+		t.Errorf("unexpected additional information: %s", body)
+	}
+
+	source := testUserResourceModel()
+	source.Password = types.StringValue("S3cr3t!")
+	newUser, _ = NewUserFromResource(context.Background(), source)
+	if newUser.Password == nil || *newUser.Password != "S3cr3t!" || newUser.PreRegistration != nil {
+		t.Errorf("unexpected new user with a password: %+v", newUser.NewUser)
+	}
+}
+
+func TestUpdateUserFromResourceMergesAdditionalInformation(t *testing.T) {
+	prior := testUserResourceModel()
+	prior.AdditionalInformation = types.MapValueMust(types.StringType, map[string]attr.Value{
+		"team":     types.StringValue("sales"),
+		"location": types.StringValue("Paris"),
+	})
+	current := UserEntity{AdditionalInformation: map[string]interface{}{
+		"team":     "sales",
+		"location": "Paris",
+		"sub":      "jdoe",
+	}}
+
+	update, diags := UpdateUserFromResource(context.Background(), testUserResourceModel(), prior, &current)
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+	if len(update.AdditionalInformation) != 2 || update.AdditionalInformation["team"] != "support" || update.AdditionalInformation["sub"] != "jdoe" {
+		t.Errorf("unexpected additional information: %v", update.AdditionalInformation)
+	}
+}
+
+func TestMapUserResource(t *testing.T) {
+	var source UserEntity
+	payload := `{
+		"id": "user",
+		"username": "jdoe",
+		"email": "jdoe@example.com",
+		"firstName": "John",
+		"enabled": false,
+		"source": "default-idp-domain",
+		"additionalInformation": {"team": "support", "sub": "jdoe", "email_verified": true}
+	}`
+	if err := json.Unmarshal([]byte(payload), &source); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	target := MapUserResource(&source, testUserResourceModel())
+	if target.Id.ValueString() != "DEFAULT:DEFAULT:domain:user" || target.Enabled.ValueBool() || target.Source.ValueString() != "default-idp-domain" {
+		t.Errorf("unexpected user: %+v", target)
+	}
+	if !target.LastName.IsNull() || !target.Password.IsNull() {
+		t.Errorf("unexpected user: %+v", target)
+	}
+	if !target.AdditionalInformation.Equal(testUserResourceModel().AdditionalInformation) {
+		t.Errorf("unexpected additional information: %v", target.AdditionalInformation)
+	}
+}
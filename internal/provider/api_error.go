@@ -0,0 +1,136 @@
+package provider
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+)
+
+// maxAPIErrorBodySize bounds how much of an error response body is read.
+const maxAPIErrorBodySize = 64 * 1024
+
+// APIError is the error payload returned by the Gravitee AM management API,
+// along with the request that caused it.
+type APIError struct {
+	Method     string `json:"-"`
+	Path       string `json:"-"`
+	Status     string `json:"-"`
+	StatusCode int    `json:"-"`
+
+	Message    string         `json:"message"`
+	HttpStatus int            `json:"http_status"`
+	Parameters map[string]any `json:"parameters"`
+}
+
+// NewAPIError decodes the error payload of a failed management API response.
+// Bodies that are not a Gravitee error payload are kept as the message.
+func NewAPIError(httpRes *http.Response) *APIError {
+	apiErr := &APIError{
+		Status:     httpRes.Status,
+		StatusCode: httpRes.StatusCode,
+	}
+	if httpRes.Request != nil {
+		apiErr.Method = httpRes.Request.Method
+		apiErr.Path = httpRes.Request.URL.Path
+	}
+
+	if httpRes.Body == nil {
+		return apiErr
+	}
+	body, err := io.ReadAll(io.LimitReader(httpRes.Body, maxAPIErrorBodySize))
+	if err != nil || len(body) == 0 {
+		return apiErr
+	}
+	if json.Unmarshal(body, apiErr) != nil || apiErr.Message == "" {
+		apiErr.Message = strings.TrimSpace(string(body))
+	}
+	return apiErr
+}
+
+func (e *APIError) Error() string {
+	message := fmt.Sprintf("%s %s returned %s", e.Method, e.Path, e.Status)
+	if e.Message != "" {
+		message += ": " + e.Message
+	}
+	return message
+}
+
+// Summary returns a short, status specific diagnostic summary.
+func (e *APIError) Summary() string {
+	switch e.StatusCode {
+	case http.StatusBadRequest:
+		return "Invalid request rejected by GraviteeIOAM"
+	case http.StatusUnauthorized:
+		return "GraviteeIOAM authentication failed"
+	case http.StatusForbidden:
+		return "Permission denied by GraviteeIOAM"
+	case http.StatusNotFound:
+		return "GraviteeIOAM object not found"
+	case http.StatusConflict:
+		return "GraviteeIOAM object already exists or conflicts"
+	}
+	return "Unexpected HTTP error code received"
+}
+
+// Detail returns the diagnostic detail, including the request, the decoded
+// message and parameters, and a hint on how to resolve the error.
+func (e *APIError) Detail() string {
+	var detail strings.Builder
+	detail.WriteString(e.Error())
+
+	if len(e.Parameters) > 0 {
+		keys := make([]string, 0, len(e.Parameters))
+		for key := range e.Parameters {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+		detail.WriteString("\n\nParameters:")
+		for _, key := range keys {
+			detail.WriteString(fmt.Sprintf("\n  %s: %v", key, e.Parameters[key]))
+		}
+	}
+
+	switch e.StatusCode {
+	case http.StatusBadRequest:
+		detail.WriteString("\n\nCheck the configured attribute values against the message above.")
+	case http.StatusUnauthorized:
+		detail.WriteString("\n\nCheck the provider credentials, the access token may be invalid or expired.")
+	case http.StatusForbidden:
+		detail.WriteString("\n\nThe authenticated user or service account lacks the permission required for this operation.")
+	case http.StatusNotFound:
+		detail.WriteString("\n\nCheck that the organization, environment, domain and object ids exist.")
+	case http.StatusConflict:
+		detail.WriteString("\n\nAn object with the same unique attributes already exists, import it or choose different values.")
+	}
+	return detail.String()
+}
+
+// addAPIErrorDiagnostic decodes a failed management API response into an
+// error diagnostic.
+func addAPIErrorDiagnostic(diags *diag.Diagnostics, httpRes *http.Response) {
+	apiErr := NewAPIError(httpRes)
+	diags.AddError(apiErr.Summary(), apiErr.Detail())
+}
+
+// isUpdateSuccess tells whether an update request succeeded.
+func isUpdateSuccess(httpRes *http.Response) bool {
+	// The update endpoint is documented to return 201, AM returns 200.
+	return httpRes.StatusCode == http.StatusOK || httpRes.StatusCode == http.StatusCreated
+}
+
+// addErrorDiagnostic reports an error returned by a helper, using the
+// decoded management API error when there is one.
+func addErrorDiagnostic(diags *diag.Diagnostics, summary string, err error) {
+	var apiErr *APIError
+	if errors.As(err, &apiErr) {
+		diags.AddError(apiErr.Summary(), apiErr.Detail())
+		return
+	}
+	diags.AddError(summary, err.Error())
+}
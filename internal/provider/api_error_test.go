@@ -0,0 +1,88 @@
+package provider
+
+import (
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+)
+
+func testAPIErrorResponse(statusCode int, body string) *http.Response {
+	return &http.Response{
+		Status:     http.StatusText(statusCode),
+		StatusCode: statusCode,
+		Body:       io.NopCloser(strings.NewReader(body)),
+		Request: &http.Request{
+			Method: http.MethodPost,
+			URL:    &url.URL{Path: "/management/organizations/DEFAULT/environments/DEFAULT/domains"},
+		},
+	}
+}
+
+func TestNewAPIErrorDecodesGraviteePayload(t *testing.T) {
+	apiErr := NewAPIError(testAPIErrorResponse(http.StatusConflict, `{"message":"Domain [test] already exists","http_status":409,"parameters":{"domain":"test"}}`))
+
+	if apiErr.Message != "Domain [test] already exists" || apiErr.HttpStatus != 409 {
+		t.Fatalf("unexpected decoded error: %+v", apiErr)
+	}
+	if apiErr.Summary() != "GraviteeIOAM object already exists or conflicts" {
+		t.Errorf("unexpected summary: %s", apiErr.Summary())
+	}
+	for _, expected := range []string{"POST /management/organizations/DEFAULT/environments/DEFAULT/domains", "Domain [test] already exists", "domain: test"} {
+		if !strings.Contains(apiErr.Detail(), expected) {
+			t.Errorf("expected detail to contain %q, got %q", expected, apiErr.Detail())
+		}
+	}
+}
+
+func TestAddAPIErrorDiagnosticSummaries(t *testing.T) {
+	for statusCode, summary := range map[int]string{
+		http.StatusBadRequest:          "Invalid request rejected by GraviteeIOAM",
+		http.StatusUnauthorized:        "GraviteeIOAM authentication failed",
+		http.StatusForbidden:           "Permission denied by GraviteeIOAM",
+		http.StatusNotFound:            "GraviteeIOAM object not found",
+		http.StatusConflict:            "GraviteeIOAM object already exists or conflicts",
+		http.StatusInternalServerError: "Unexpected HTTP error code received",
+	} {
+		var diags diag.Diagnostics
+		addAPIErrorDiagnostic(&diags, testAPIErrorResponse(statusCode, "plain text failure"))
+
+		if len(diags) != 1 || diags[0].Summary() != summary {
+			t.Errorf("%d: expected summary %q, got %v", statusCode, summary, diags)
+			continue
+		}
+		if !strings.Contains(diags[0].Detail(), "plain text failure") {
+			t.Errorf("%d: expected a non JSON body to be kept as the message, got %q", statusCode, diags[0].Detail())
+		}
+	}
+}
+
+func TestNewAPIErrorKeepsRequestFields(t *testing.T) {
+	apiErr := NewAPIError(testAPIErrorResponse(http.StatusBadRequest, `{"message":"Invalid","status":"OK","statusCode":200,"path":"/other","method":"GET"}`))
+
+	if apiErr.Status != http.StatusText(http.StatusBadRequest) || apiErr.StatusCode != http.StatusBadRequest {
+		t.Errorf("expected the response status to be kept, got %q %d", apiErr.Status, apiErr.StatusCode)
+	}
+	if apiErr.Method != http.MethodPost || apiErr.Path != "/management/organizations/DEFAULT/environments/DEFAULT/domains" {
+		t.Errorf("expected the request to be kept, got %s %s", apiErr.Method, apiErr.Path)
+	}
+	if apiErr.Message != "Invalid" {
+		t.Errorf("unexpected message: %s", apiErr.Message)
+	}
+}
+
+func TestIsUpdateSuccess(t *testing.T) {
+	for statusCode, expected := range map[int]bool{
+		http.StatusOK:         true,
+		http.StatusCreated:    true,
+		http.StatusNoContent:  false,
+		http.StatusBadRequest: false,
+	} {
+		if isUpdateSuccess(&http.Response{StatusCode: statusCode}) != expected {
+			t.Errorf("%d: expected %t", statusCode, expected)
+		}
+	}
+}
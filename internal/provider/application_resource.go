@@ -0,0 +1,314 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/thornleyk/graviteeioam-service/client"
+	applicationModel "github.com/thornleyk/terraform-provider-graviteeioam/internal/model/application"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var _ resource.Resource = &ApplicationResource{}
+var _ resource.ResourceWithImportState = &ApplicationResource{}
+
+func NewApplicationResource() resource.Resource {
+	return &ApplicationResource{}
+}
+
+type ApplicationResource struct {
+	client *client.Client
+}
+
+func ParseApplicationID(id string) (string, string, string, string, error) {
+	parts := strings.SplitN(id, ":", 4)
+	if len(parts) != 4 || parts[0] == "" || parts[1] == "" || parts[2] == "" || parts[3] == "" {
+		return "", "", "", "", fmt.Errorf("unexpected format of ID (%s), expected organizationId:environmentId:domainId:applicationId", id)
+	}
+	return parts[0], parts[1], parts[2], parts[3], nil
+}
+
+func (r *ApplicationResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_application"
+}
+
+func (r *ApplicationResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = *applicationModel.GetApplicationResourceSchema()
+}
+
+func (r *ApplicationResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *ApplicationResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data applicationModel.ApplicationResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	organizationId := data.OrganizationId.ValueString()
+	environmentId := data.EnvironmentId.ValueString()
+	domainId := data.DomainId.ValueString()
+
+	newApplication, diags := applicationModel.NewApplicationFromResource(ctx, data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	httpRes, err := r.client.EnvironmentCreateDomainApplication(ctx, organizationId, environmentId, domainId, newApplication)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to create item",
+			err.Error(),
+		)
+		return
+	}
+	defer httpRes.Body.Close()
+
+	if httpRes.StatusCode != 201 {
+		addAPIErrorDiagnostic(&resp.Diagnostics, httpRes)
+		return
+	}
+
+	var created client.Application
+	if err := json.NewDecoder(httpRes.Body).Decode(&created); err != nil {
+		resp.Diagnostics.AddError(
+			"Invalid format received",
+			err.Error(),
+		)
+		return
+	}
+
+	// The created application is kept in state should the follow-up patch
+	// fail, so that it is not orphaned.
+	createdData, diags := applicationModel.MapApplicationResource(ctx, &created, data)
+	resp.Diagnostics.Append(diags...)
+
+	// The create endpoint only accepts the name, type, description and
+	// redirect URIs, the remaining attributes are applied with a follow-up
+	// patch.
+	patch, diags := applicationModel.PatchApplicationFromResource(ctx, data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		resp.Diagnostics.Append(resp.State.Set(ctx, &createdData)...)
+		return
+	}
+
+	apiRes, ok := r.patchApplication(ctx, organizationId, environmentId, domainId, *created.Id, patch, &resp.Diagnostics)
+	if !ok {
+		resp.Diagnostics.Append(resp.State.Set(ctx, &createdData)...)
+		return
+	}
+
+	// The client secret is only guaranteed to be returned on creation.
+	data.ClientSecret = createdData.ClientSecret
+	data, diags = applicationModel.MapApplicationResource(ctx, apiRes, data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Trace(ctx, "created a resource")
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ApplicationResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data applicationModel.ApplicationResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	httpRes, err := r.client.ApplicationGetDomainApplication(ctx, data.OrganizationId.ValueString(), data.EnvironmentId.ValueString(), data.DomainId.ValueString(), data.ApplicationId.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to read item",
+			err.Error(),
+		)
+		return
+	}
+	defer httpRes.Body.Close()
+
+	if httpRes.StatusCode == 404 {
+		tflog.Warn(ctx, "Application not found, removing from state", map[string]any{"id": data.Id.ValueString()})
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	if httpRes.StatusCode != 200 {
+		addAPIErrorDiagnostic(&resp.Diagnostics, httpRes)
+		return
+	}
+
+	var apiRes client.Application
+	if err := json.NewDecoder(httpRes.Body).Decode(&apiRes); err != nil {
+		resp.Diagnostics.AddError(
+			"Invalid format received",
+			err.Error(),
+		)
+		return
+	}
+
+	data, diags := applicationModel.MapApplicationResource(ctx, &apiRes, data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ApplicationResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data, state applicationModel.ApplicationResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	organizationId := data.OrganizationId.ValueString()
+	environmentId := data.EnvironmentId.ValueString()
+	domainId := data.DomainId.ValueString()
+	applicationId := data.ApplicationId.ValueString()
+
+	if !data.Type.Equal(state.Type) {
+		httpRes, err := r.client.ApplicationUpdateType(ctx, organizationId, environmentId, domainId, applicationId, client.PatchApplicationType{
+			Type: client.PatchApplicationTypeType(applicationModel.APIApplicationType(data.Type)),
+		})
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Unable to update item",
+				err.Error(),
+			)
+			return
+		}
+		defer httpRes.Body.Close()
+
+		if httpRes.StatusCode != 200 {
+			addAPIErrorDiagnostic(&resp.Diagnostics, httpRes)
+			return
+		}
+	}
+
+	patch, diags := applicationModel.PatchApplicationFromResource(ctx, data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	apiRes, ok := r.patchApplication(ctx, organizationId, environmentId, domainId, applicationId, patch, &resp.Diagnostics)
+	if !ok {
+		return
+	}
+
+	data, diags = applicationModel.MapApplicationResource(ctx, apiRes, data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Trace(ctx, "updated a resource")
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ApplicationResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data applicationModel.ApplicationResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	httpRes, err := r.client.DomainDeleteApplication(ctx, data.OrganizationId.ValueString(), data.EnvironmentId.ValueString(), data.DomainId.ValueString(), data.ApplicationId.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to delete item",
+			err.Error(),
+		)
+		return
+	}
+	defer httpRes.Body.Close()
+
+	if httpRes.StatusCode != 204 && httpRes.StatusCode != 404 {
+		addAPIErrorDiagnostic(&resp.Diagnostics, httpRes)
+		return
+	}
+
+	tflog.Trace(ctx, "deleted a resource")
+}
+
+func (r *ApplicationResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	organizationId, environmentId, domainId, applicationId, idErr := ParseApplicationID(req.ID)
+	if idErr != nil {
+		resp.Diagnostics.AddError(
+			"Error parsing id",
+			idErr.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("organization_id"), organizationId)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("environment_id"), environmentId)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("domain_id"), domainId)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("application_id"), applicationId)...)
+}
+
+func (r *ApplicationResource) patchApplication(ctx context.Context, organizationId string, environmentId string, domainId string, applicationId string, patch client.PatchApplication, diags *diag.Diagnostics) (*client.Application, bool) {
+	httpRes, err := r.client.EnvironmentPatchDomainApplication(ctx, organizationId, environmentId, domainId, applicationId, patch)
+	if err != nil {
+		diags.AddError(
+			"Unable to update item",
+			err.Error(),
+		)
+		return nil, false
+	}
+	defer httpRes.Body.Close()
+
+	if httpRes.StatusCode != 200 {
+		addAPIErrorDiagnostic(diags, httpRes)
+		return nil, false
+	}
+
+	var apiRes client.Application
+	if err := json.NewDecoder(httpRes.Body).Decode(&apiRes); err != nil {
+		diags.AddError(
+			"Invalid format received",
+			err.Error(),
+		)
+		return nil, false
+	}
+	return &apiRes, true
+}
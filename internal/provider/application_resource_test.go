@@ -0,0 +1,62 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccApplicationResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + testAccApplicationResourceConfig("web", "https://example.com/callback"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("graviteeioam_application.test", "name", "tf-acc-application"),
+					resource.TestCheckResourceAttr("graviteeioam_application.test", "type", "web"),
+					resource.TestCheckResourceAttr("graviteeioam_application.test", "redirect_uris.0", "https://example.com/callback"),
+					resource.TestCheckResourceAttr("graviteeioam_application.test", "access_token_validity_seconds", "600"),
+					resource.TestCheckResourceAttrSet("graviteeioam_application.test", "application_id"),
+					resource.TestCheckResourceAttrSet("graviteeioam_application.test", "client_id"),
+				),
+			},
+			{
+				ResourceName:            "graviteeioam_application.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"client_secret"},
+			},
+			{
+				Config: providerConfig + testAccApplicationResourceConfig("spa", "https://example.com/spa"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("graviteeioam_application.test", "type", "spa"),
+					resource.TestCheckResourceAttr("graviteeioam_application.test", "redirect_uris.0", "https://example.com/spa"),
+				),
+			},
+		},
+	})
+}
+
+func testAccApplicationResourceConfig(applicationType string, redirectURI string) string {
+	return fmt.Sprintf(`
+resource "graviteeioam_domain" "test" {
+  organization_id = "DEFAULT"
+  environment_id  = "DEFAULT"
+  name            = "tf-acc-application-domain"
+}
+
+resource "graviteeioam_application" "test" {
+  organization_id = graviteeioam_domain.test.organization_id
+  environment_id  = graviteeioam_domain.test.environment_id
+  domain_id       = graviteeioam_domain.test.domain_id
+  name            = "tf-acc-application"
+  type            = %[1]q
+  redirect_uris   = [%[2]q]
+
+  access_token_validity_seconds = 600
+}
+`, applicationType, redirectURI)
+}
@@ -0,0 +1,305 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/thornleyk/graviteeioam-service/client"
+	certificateModel "github.com/thornleyk/terraform-provider-graviteeioam/internal/model/certificate"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var _ resource.Resource = &CertificateResource{}
+var _ resource.ResourceWithImportState = &CertificateResource{}
+
+func NewCertificateResource() resource.Resource {
+	return &CertificateResource{}
+}
+
+type CertificateResource struct {
+	client *client.Client
+}
+
+func ParseCertificateID(id string) (string, string, string, string, error) {
+	parts := strings.SplitN(id, ":", 4)
+	if len(parts) != 4 || parts[0] == "" || parts[1] == "" || parts[2] == "" || parts[3] == "" {
+		return "", "", "", "", fmt.Errorf("unexpected format of ID (%s), expected organizationId:environmentId:domainId:certificateId", id)
+	}
+	return parts[0], parts[1], parts[2], parts[3], nil
+}
+
+func (r *CertificateResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_certificate"
+}
+
+func (r *CertificateResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = *certificateModel.GetCertificateResourceSchema()
+}
+
+func (r *CertificateResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *CertificateResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data certificateModel.CertificateResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	organizationId := data.OrganizationId.ValueString()
+	environmentId := data.EnvironmentId.ValueString()
+	domainId := data.DomainId.ValueString()
+
+	newCertificate, diags := certificateModel.NewCertificateFromResource(data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	httpRes, err := r.client.EnvironmentCreateDomainCertificate(ctx, organizationId, environmentId, domainId, newCertificate)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to create item",
+			err.Error(),
+		)
+		return
+	}
+	defer httpRes.Body.Close()
+
+	if httpRes.StatusCode != 201 {
+		addAPIErrorDiagnostic(&resp.Diagnostics, httpRes)
+		return
+	}
+
+	var apiRes client.CertificateEntity
+	if err := json.NewDecoder(httpRes.Body).Decode(&apiRes); err != nil {
+		resp.Diagnostics.AddError(
+			"Invalid format received",
+			err.Error(),
+		)
+		return
+	}
+
+	// The created certificate is kept in state even when its public key
+	// cannot be read, so that it is not orphaned. The public key is left
+	// null and read again on the next refresh.
+	var keyDiags diag.Diagnostics
+	publicKey, ok := r.readPublicKey(ctx, organizationId, environmentId, domainId, *apiRes.Id, &keyDiags)
+	if !ok {
+		for _, keyDiag := range keyDiags.Errors() {
+			resp.Diagnostics.AddWarning(keyDiag.Summary(), keyDiag.Detail())
+		}
+	}
+	data = certificateModel.MapCertificateResource(&apiRes, publicKey, data)
+
+	tflog.Trace(ctx, "created a resource")
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *CertificateResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data certificateModel.CertificateResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	organizationId := data.OrganizationId.ValueString()
+	environmentId := data.EnvironmentId.ValueString()
+	domainId := data.DomainId.ValueString()
+	certificateId := data.CertificateId.ValueString()
+
+	httpRes, err := r.client.DomainGetCertificate(ctx, organizationId, environmentId, domainId, certificateId)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to read item",
+			err.Error(),
+		)
+		return
+	}
+	defer httpRes.Body.Close()
+
+	if httpRes.StatusCode == 404 {
+		tflog.Warn(ctx, "Certificate not found, removing from state", map[string]any{"id": data.Id.ValueString()})
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	if httpRes.StatusCode != 200 {
+		addAPIErrorDiagnostic(&resp.Diagnostics, httpRes)
+		return
+	}
+
+	var apiRes client.CertificateEntity
+	if err := json.NewDecoder(httpRes.Body).Decode(&apiRes); err != nil {
+		resp.Diagnostics.AddError(
+			"Invalid format received",
+			err.Error(),
+		)
+		return
+	}
+
+	publicKey, ok := r.readPublicKey(ctx, organizationId, environmentId, domainId, certificateId, &resp.Diagnostics)
+	if !ok {
+		return
+	}
+
+	data = certificateModel.MapCertificateResource(&apiRes, publicKey, data)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *CertificateResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data certificateModel.CertificateResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	organizationId := data.OrganizationId.ValueString()
+	environmentId := data.EnvironmentId.ValueString()
+	domainId := data.DomainId.ValueString()
+	certificateId := data.CertificateId.ValueString()
+
+	update, diags := certificateModel.UpdateCertificateFromResource(data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	httpRes, err := r.client.DomainUpdateCertificate(ctx, organizationId, environmentId, domainId, certificateId, update)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to update item",
+			err.Error(),
+		)
+		return
+	}
+	defer httpRes.Body.Close()
+
+	if !isUpdateSuccess(httpRes) {
+		addAPIErrorDiagnostic(&resp.Diagnostics, httpRes)
+		return
+	}
+
+	var apiRes client.CertificateEntity
+	if err := json.NewDecoder(httpRes.Body).Decode(&apiRes); err != nil {
+		resp.Diagnostics.AddError(
+			"Invalid format received",
+			err.Error(),
+		)
+		return
+	}
+
+	publicKey, ok := r.readPublicKey(ctx, organizationId, environmentId, domainId, certificateId, &resp.Diagnostics)
+	if !ok {
+		return
+	}
+
+	data = certificateModel.MapCertificateResource(&apiRes, publicKey, data)
+
+	tflog.Trace(ctx, "updated a resource")
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *CertificateResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data certificateModel.CertificateResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	httpRes, err := r.client.DomainDeleteCertificate(ctx, data.OrganizationId.ValueString(), data.EnvironmentId.ValueString(), data.DomainId.ValueString(), data.CertificateId.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to delete item",
+			err.Error(),
+		)
+		return
+	}
+	defer httpRes.Body.Close()
+
+	if httpRes.StatusCode != 204 && httpRes.StatusCode != 404 {
+		addAPIErrorDiagnostic(&resp.Diagnostics, httpRes)
+		return
+	}
+
+	tflog.Trace(ctx, "deleted a resource")
+}
+
+func (r *CertificateResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	organizationId, environmentId, domainId, certificateId, idErr := ParseCertificateID(req.ID)
+	if idErr != nil {
+		resp.Diagnostics.AddError(
+			"Error parsing id",
+			idErr.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("organization_id"), organizationId)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("environment_id"), environmentId)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("domain_id"), domainId)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("certificate_id"), certificateId)...)
+}
+
+// readPublicKey returns the public key of a certificate, nil when the
+// certificate exposes none.
+func (r *CertificateResource) readPublicKey(ctx context.Context, organizationId string, environmentId string, domainId string, certificateId string, diags *diag.Diagnostics) (*string, bool) {
+	httpRes, err := r.client.EnvironmentListDomainCertificatePublicKeys(ctx, organizationId, environmentId, domainId, certificateId)
+	if err != nil {
+		diags.AddError(
+			"Unable to read item",
+			err.Error(),
+		)
+		return nil, false
+	}
+	defer httpRes.Body.Close()
+
+	if httpRes.StatusCode != 200 {
+		addAPIErrorDiagnostic(diags, httpRes)
+		return nil, false
+	}
+
+	var apiRes []client.CertificateKey
+	if err := json.NewDecoder(httpRes.Body).Decode(&apiRes); err != nil {
+		diags.AddError(
+			"Invalid format received",
+			err.Error(),
+		)
+		return nil, false
+	}
+	return certificateModel.PublicKey(apiRes), true
+}
@@ -0,0 +1,76 @@
+package provider
+
+import (
+	"encoding/base64"
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccCertificateResource(t *testing.T) {
+	keystore, err := os.ReadFile("testdata/certificate.p12")
+	if err != nil {
+		t.Fatalf("unable to read the keystore: %s", err)
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + testAccCertificateResourceConfig("tf-acc-certificate", base64.StdEncoding.EncodeToString(keystore)),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("graviteeioam_certificate.test", "name", "tf-acc-certificate"),
+					resource.TestCheckResourceAttr("graviteeioam_certificate.test", "type", "pkcs12"),
+					resource.TestCheckResourceAttr("graviteeioam_certificate.test", "alias", "tf-acc"),
+					resource.TestCheckResourceAttrSet("graviteeioam_certificate.test", "certificate_id"),
+					resource.TestCheckResourceAttrSet("graviteeioam_certificate.test", "expires_at"),
+					resource.TestCheckResourceAttrSet("graviteeioam_certificate.test", "public_key"),
+					resource.TestCheckResourceAttrPair("data.graviteeioam_certificates.test", "id", "graviteeioam_domain.test", "id"),
+				),
+			},
+			{
+				ResourceName:            "graviteeioam_certificate.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"keystore", "store_password", "key_password"},
+			},
+			{
+				Config: providerConfig + testAccCertificateResourceConfig("tf-acc-certificate-renamed", base64.StdEncoding.EncodeToString(keystore)),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("graviteeioam_certificate.test", "name", "tf-acc-certificate-renamed"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCertificateResourceConfig(name string, keystore string) string {
+	return fmt.Sprintf(`
+resource "graviteeioam_domain" "test" {
+  organization_id = "DEFAULT"
+  environment_id  = "DEFAULT"
+  name            = "tf-acc-certificate-domain"
+}
+
+resource "graviteeioam_certificate" "test" {
+  organization_id = graviteeioam_domain.test.organization_id
+  environment_id  = graviteeioam_domain.test.environment_id
+  domain_id       = graviteeioam_domain.test.domain_id
+  name            = %[1]q
+  type            = "pkcs12"
+  keystore        = %[2]q
+  alias           = "tf-acc"
+  store_password  = "changeit"
+  key_password    = "changeit"
+}
+
+data "graviteeioam_certificates" "test" {
+  organization_id = graviteeioam_certificate.test.organization_id
+  environment_id  = graviteeioam_certificate.test.environment_id
+  domain_id       = graviteeioam_certificate.test.domain_id
+}
+`, name, keystore)
+}
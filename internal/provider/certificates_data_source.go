@@ -0,0 +1,97 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/thornleyk/graviteeioam-service/client"
+	certificateModel "github.com/thornleyk/terraform-provider-graviteeioam/internal/model/certificate"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var _ datasource.DataSource = &CertificatesDataSource{}
+
+func NewCertificatesDataSource() datasource.DataSource {
+	return &CertificatesDataSource{}
+}
+
+type CertificatesDataSource struct {
+	client *client.Client
+}
+
+func (d *CertificatesDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_certificates"
+}
+
+func (d *CertificatesDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = *certificateModel.GetCertificatesDataSourceSchema()
+}
+
+func (d *CertificatesDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+func (d *CertificatesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data certificateModel.CertificatesDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	httpRes, err := d.client.EnvironmentListDomainCertificates(ctx, data.OrganizationId.ValueString(), data.EnvironmentId.ValueString(), data.DomainId.ValueString(), &client.EnvironmentListDomainCertificatesParams{})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to read item",
+			err.Error(),
+		)
+		return
+	}
+	defer httpRes.Body.Close()
+
+	if httpRes.StatusCode != 200 {
+		addAPIErrorDiagnostic(&resp.Diagnostics, httpRes)
+		return
+	}
+
+	var apiRes []client.CertificateEntity
+	if err := json.NewDecoder(httpRes.Body).Decode(&apiRes); err != nil {
+		resp.Diagnostics.AddError(
+			"Invalid format received",
+			err.Error(),
+		)
+		return
+	}
+
+	data, mapErr := certificateModel.MapCertificatesDataSource(apiRes, data)
+	if mapErr != nil {
+		resp.Diagnostics.AddError(
+			"Unable to read data source",
+			mapErr.Error(),
+		)
+		return
+	}
+
+	tflog.Trace(ctx, "read a data source")
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
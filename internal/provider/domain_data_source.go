@@ -87,10 +87,7 @@ func (d *DomainDataSource) Read(ctx context.Context, req datasource.ReadRequest,
 
 	var apiRes client.Domain
 	if httpRes.StatusCode != 200 {
-		resp.Diagnostics.AddError(
-			"Unexpected HTTP error code received",
-			httpRes.Status,
-		)
+		addAPIErrorDiagnostic(&resp.Diagnostics, httpRes)
 		return
 	}
 
@@ -67,16 +67,7 @@ func (d *DomainIdentityProviderDataSource) Read(ctx context.Context, req datasou
 		return
 	}
 
-	organizationId, environmentId, domainId, identityProviderId, idErr := ParseDomainIdentityProviderID(data.DomainId.ValueString())
-	if idErr != nil {
-		resp.Diagnostics.AddError(
-			"Error parsing id",
-			data.DomainId.String(),
-		)
-		return
-	}
-
-	httpRes, err := d.client.DomainGetIdentityProvider(ctx, organizationId, environmentId, domainId, identityProviderId)
+	httpRes, err := d.client.DomainGetIdentityProvider(ctx, data.OrganizationId.ValueString(), data.EnvironmentId.ValueString(), data.DomainId.ValueString(), data.IdentityProviderId.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Unable to read item",
@@ -84,13 +75,11 @@ func (d *DomainIdentityProviderDataSource) Read(ctx context.Context, req datasou
 		)
 		return
 	}
+	defer httpRes.Body.Close()
 
 	var apiRes client.IdentityProvider
 	if httpRes.StatusCode != 200 {
-		resp.Diagnostics.AddError(
-			"Unexpected HTTP error code received",
-			httpRes.Status,
-		)
+		addAPIErrorDiagnostic(&resp.Diagnostics, httpRes)
 		return
 	}
 
@@ -1 +1,55 @@
 package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccDomainIdentityProviderDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + testAccDomainIdentityProviderDataSourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair("data.graviteeioam_domain_identity_provider.test", "id", "graviteeioam_domain_identity_provider.test", "id"),
+					resource.TestCheckResourceAttr("data.graviteeioam_domain_identity_provider.test", "name", "tf-acc-idp-data"),
+					resource.TestCheckResourceAttr("data.graviteeioam_domain_identity_provider.test", "type", "inline-am-idp"),
+					resource.TestCheckResourceAttr("data.graviteeioam_domain_identity_provider.test", "user_mappers.email.mapping", "mail"),
+				),
+			},
+		},
+	})
+}
+
+const testAccDomainIdentityProviderDataSourceConfig = `
+resource "graviteeioam_domain" "test" {
+  organization_id = "DEFAULT"
+  environment_id  = "DEFAULT"
+  name            = "tf-acc-idp-data-domain"
+}
+
+resource "graviteeioam_domain_identity_provider" "test" {
+  organization_id = graviteeioam_domain.test.organization_id
+  environment_id  = graviteeioam_domain.test.environment_id
+  domain_id       = graviteeioam_domain.test.domain_id
+  type            = "inline-am-idp"
+  name            = "tf-acc-idp-data"
+  configuration = jsonencode({
+    passwordEncoder = "BCrypt"
+    users           = []
+  })
+  user_mappers = {
+    email = "mail"
+  }
+}
+
+data "graviteeioam_domain_identity_provider" "test" {
+  organization_id      = graviteeioam_domain_identity_provider.test.organization_id
+  environment_id       = graviteeioam_domain_identity_provider.test.environment_id
+  domain_id            = graviteeioam_domain_identity_provider.test.domain_id
+  identity_provider_id = graviteeioam_domain_identity_provider.test.identity_provider_id
+}
+`
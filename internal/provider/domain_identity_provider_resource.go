@@ -1 +1,265 @@
 package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/thornleyk/graviteeioam-service/client"
+	domainIdentityModel "github.com/thornleyk/terraform-provider-graviteeioam/internal/model/domain_identity"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var _ resource.Resource = &DomainIdentityProviderResource{}
+var _ resource.ResourceWithImportState = &DomainIdentityProviderResource{}
+
+func NewDomainIdentityProviderResource() resource.Resource {
+	return &DomainIdentityProviderResource{}
+}
+
+type DomainIdentityProviderResource struct {
+	client *client.Client
+}
+
+func (r *DomainIdentityProviderResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_domain_identity_provider"
+}
+
+func (r *DomainIdentityProviderResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = *domainIdentityModel.GetDomainIdentityResourceSchema()
+}
+
+func (r *DomainIdentityProviderResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *DomainIdentityProviderResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data domainIdentityModel.DomainIdentityResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	organizationId := data.OrganizationId.ValueString()
+	environmentId := data.EnvironmentId.ValueString()
+	domainId := data.DomainId.ValueString()
+
+	newIdentityProvider, diags := domainIdentityModel.NewDomainIdentityFromResource(ctx, data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	httpRes, err := r.client.EnvironmentCreateDomainIdentityProvider(ctx, organizationId, environmentId, domainId, newIdentityProvider)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to create item",
+			err.Error(),
+		)
+		return
+	}
+	defer httpRes.Body.Close()
+
+	if httpRes.StatusCode != 201 {
+		addAPIErrorDiagnostic(&resp.Diagnostics, httpRes)
+		return
+	}
+
+	var apiRes client.IdentityProvider
+	if err := json.NewDecoder(httpRes.Body).Decode(&apiRes); err != nil {
+		resp.Diagnostics.AddError(
+			"Invalid format received",
+			err.Error(),
+		)
+		return
+	}
+
+	// The create endpoint does not accept the mappers, they are applied with
+	// a follow-up update.
+	if !data.UserMappers.IsNull() || !data.RoleMappers.IsNull() {
+		update, diags := domainIdentityModel.UpdateDomainIdentityFromResource(ctx, data)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		updated, ok := r.updateIdentityProvider(ctx, organizationId, environmentId, domainId, *apiRes.Id, update, &resp.Diagnostics)
+		if !ok {
+			// Keep the created identity provider in state so that it is not
+			// orphaned.
+			data = domainIdentityModel.MapDomainIdentityResource(&apiRes, data)
+			resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+			return
+		}
+		apiRes = *updated
+	}
+
+	data = domainIdentityModel.MapDomainIdentityResource(&apiRes, data)
+
+	tflog.Trace(ctx, "created a resource")
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *DomainIdentityProviderResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data domainIdentityModel.DomainIdentityResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	httpRes, err := r.client.DomainGetIdentityProvider(ctx, data.OrganizationId.ValueString(), data.EnvironmentId.ValueString(), data.DomainId.ValueString(), data.IdentityProviderId.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to read item",
+			err.Error(),
+		)
+		return
+	}
+	defer httpRes.Body.Close()
+
+	if httpRes.StatusCode == 404 {
+		tflog.Warn(ctx, "Identity provider not found, removing from state", map[string]any{"id": data.Id.ValueString()})
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	if httpRes.StatusCode != 200 {
+		addAPIErrorDiagnostic(&resp.Diagnostics, httpRes)
+		return
+	}
+
+	var apiRes client.IdentityProvider
+	if err := json.NewDecoder(httpRes.Body).Decode(&apiRes); err != nil {
+		resp.Diagnostics.AddError(
+			"Invalid format received",
+			err.Error(),
+		)
+		return
+	}
+
+	data = domainIdentityModel.MapDomainIdentityResource(&apiRes, data)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *DomainIdentityProviderResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data domainIdentityModel.DomainIdentityResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	update, diags := domainIdentityModel.UpdateDomainIdentityFromResource(ctx, data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	apiRes, ok := r.updateIdentityProvider(ctx, data.OrganizationId.ValueString(), data.EnvironmentId.ValueString(), data.DomainId.ValueString(), data.IdentityProviderId.ValueString(), update, &resp.Diagnostics)
+	if !ok {
+		return
+	}
+
+	data = domainIdentityModel.MapDomainIdentityResource(apiRes, data)
+
+	tflog.Trace(ctx, "updated a resource")
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *DomainIdentityProviderResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data domainIdentityModel.DomainIdentityResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	httpRes, err := r.client.DomainDeleteIdentityProvider(ctx, data.OrganizationId.ValueString(), data.EnvironmentId.ValueString(), data.DomainId.ValueString(), data.IdentityProviderId.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to delete item",
+			err.Error(),
+		)
+		return
+	}
+	defer httpRes.Body.Close()
+
+	if httpRes.StatusCode != 204 && httpRes.StatusCode != 404 {
+		addAPIErrorDiagnostic(&resp.Diagnostics, httpRes)
+		return
+	}
+
+	tflog.Trace(ctx, "deleted a resource")
+}
+
+func (r *DomainIdentityProviderResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	organizationId, environmentId, domainId, identityProviderId, idErr := ParseDomainIdentityProviderID(req.ID)
+	if idErr != nil {
+		resp.Diagnostics.AddError(
+			"Error parsing id",
+			idErr.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("organization_id"), organizationId)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("environment_id"), environmentId)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("domain_id"), domainId)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("identity_provider_id"), identityProviderId)...)
+}
+
+func (r *DomainIdentityProviderResource) updateIdentityProvider(ctx context.Context, organizationId string, environmentId string, domainId string, identityProviderId string, update client.UpdateIdentityProvider, diags *diag.Diagnostics) (*client.IdentityProvider, bool) {
+	httpRes, err := r.client.DomainUpdateIdentityProvider(ctx, organizationId, environmentId, domainId, identityProviderId, update)
+	if err != nil {
+		diags.AddError(
+			"Unable to update item",
+			err.Error(),
+		)
+		return nil, false
+	}
+	defer httpRes.Body.Close()
+
+	if !isUpdateSuccess(httpRes) {
+		addAPIErrorDiagnostic(diags, httpRes)
+		return nil, false
+	}
+
+	var apiRes client.IdentityProvider
+	if err := json.NewDecoder(httpRes.Body).Decode(&apiRes); err != nil {
+		diags.AddError(
+			"Invalid format received",
+			err.Error(),
+		)
+		return nil, false
+	}
+	return &apiRes, true
+}
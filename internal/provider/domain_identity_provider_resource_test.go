@@ -1 +1,64 @@
 package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccDomainIdentityProviderResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + testAccDomainIdentityProviderResourceConfig("tf-acc-idp", "mail"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("graviteeioam_domain_identity_provider.test", "name", "tf-acc-idp"),
+					resource.TestCheckResourceAttr("graviteeioam_domain_identity_provider.test", "type", "inline-am-idp"),
+					resource.TestCheckResourceAttr("graviteeioam_domain_identity_provider.test", "user_mappers.email", "mail"),
+					resource.TestCheckResourceAttrSet("graviteeioam_domain_identity_provider.test", "identity_provider_id"),
+				),
+			},
+			{
+				ResourceName:            "graviteeioam_domain_identity_provider.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"configuration"},
+			},
+			{
+				Config: providerConfig + testAccDomainIdentityProviderResourceConfig("tf-acc-idp-renamed", "email"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("graviteeioam_domain_identity_provider.test", "name", "tf-acc-idp-renamed"),
+					resource.TestCheckResourceAttr("graviteeioam_domain_identity_provider.test", "user_mappers.email", "email"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDomainIdentityProviderResourceConfig(name string, emailAttribute string) string {
+	return fmt.Sprintf(`
+resource "graviteeioam_domain" "test" {
+  organization_id = "DEFAULT"
+  environment_id  = "DEFAULT"
+  name            = "tf-acc-idp-domain"
+}
+
+resource "graviteeioam_domain_identity_provider" "test" {
+  organization_id = graviteeioam_domain.test.organization_id
+  environment_id  = graviteeioam_domain.test.environment_id
+  domain_id       = graviteeioam_domain.test.domain_id
+  type            = "inline-am-idp"
+  name            = %[1]q
+  configuration = jsonencode({
+    passwordEncoder = "BCrypt"
+    users           = []
+  })
+  user_mappers = {
+    email = %[2]q
+  }
+}
+`, name, emailAttribute)
+}
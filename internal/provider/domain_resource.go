@@ -2,14 +2,15 @@ package provider
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 
 	"github.com/thornleyk/graviteeioam-service/client"
 	domainModel "github.com/thornleyk/terraform-provider-graviteeioam/internal/model/domain"
 
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
-	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
 
@@ -42,7 +43,7 @@ func (r *DomainResource) Configure(ctx context.Context, req resource.ConfigureRe
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Resource Configure Type",
-			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 
 		return
@@ -60,7 +61,58 @@ func (r *DomainResource) Create(ctx context.Context, req resource.CreateRequest,
 		return
 	}
 
-	data.Id = types.StringValue("example-id")
+	organizationId := data.OrganizationId.ValueString()
+	environmentId := data.EnvironmentId.ValueString()
+
+	httpRes, err := r.client.EnvironmentCreateDomain(ctx, organizationId, environmentId, domainModel.NewDomainFromResource(data))
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to create item",
+			err.Error(),
+		)
+		return
+	}
+	defer httpRes.Body.Close()
+
+	if httpRes.StatusCode != 201 {
+		addAPIErrorDiagnostic(&resp.Diagnostics, httpRes)
+		return
+	}
+
+	var created client.Domain
+	if err := json.NewDecoder(httpRes.Body).Decode(&created); err != nil {
+		resp.Diagnostics.AddError(
+			"Invalid format received",
+			err.Error(),
+		)
+		return
+	}
+
+	// The created domain is kept in state should the follow-up patch fail,
+	// so that it is not orphaned.
+	createdData, diags := domainModel.MapDomainResource(ctx, &created, data)
+	resp.Diagnostics.Append(diags...)
+
+	// The create endpoint only accepts name and description, the remaining
+	// attributes are applied with a follow-up patch.
+	patch, diags := domainModel.PatchDomainFromResource(ctx, data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		resp.Diagnostics.Append(resp.State.Set(ctx, &createdData)...)
+		return
+	}
+
+	apiRes, ok := r.patchDomain(ctx, organizationId, environmentId, *created.Id, patch, &resp.Diagnostics)
+	if !ok {
+		resp.Diagnostics.Append(resp.State.Set(ctx, &createdData)...)
+		return
+	}
+
+	data, diags = domainModel.MapDomainResource(ctx, apiRes, data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
 	tflog.Trace(ctx, "created a resource")
 
@@ -76,6 +128,42 @@ func (r *DomainResource) Read(ctx context.Context, req resource.ReadRequest, res
 		return
 	}
 
+	httpRes, err := r.client.DomainGet(ctx, data.OrganizationId.ValueString(), data.EnvironmentId.ValueString(), data.DomainId.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to read item",
+			err.Error(),
+		)
+		return
+	}
+	defer httpRes.Body.Close()
+
+	if httpRes.StatusCode == 404 {
+		tflog.Warn(ctx, "Domain not found, removing from state", map[string]any{"id": data.Id.ValueString()})
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	if httpRes.StatusCode != 200 {
+		addAPIErrorDiagnostic(&resp.Diagnostics, httpRes)
+		return
+	}
+
+	var apiRes client.Domain
+	if err := json.NewDecoder(httpRes.Body).Decode(&apiRes); err != nil {
+		resp.Diagnostics.AddError(
+			"Invalid format received",
+			err.Error(),
+		)
+		return
+	}
+
+	data, diags := domainModel.MapDomainResource(ctx, &apiRes, data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
@@ -88,6 +176,25 @@ func (r *DomainResource) Update(ctx context.Context, req resource.UpdateRequest,
 		return
 	}
 
+	patch, diags := domainModel.PatchDomainFromResource(ctx, data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	apiRes, ok := r.patchDomain(ctx, data.OrganizationId.ValueString(), data.EnvironmentId.ValueString(), data.DomainId.ValueString(), patch, &resp.Diagnostics)
+	if !ok {
+		return
+	}
+
+	data, diags = domainModel.MapDomainResource(ctx, apiRes, data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Trace(ctx, "updated a resource")
+
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
@@ -100,8 +207,63 @@ func (r *DomainResource) Delete(ctx context.Context, req resource.DeleteRequest,
 		return
 	}
 
+	httpRes, err := r.client.DomainDelete(ctx, data.OrganizationId.ValueString(), data.EnvironmentId.ValueString(), data.DomainId.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to delete item",
+			err.Error(),
+		)
+		return
+	}
+	defer httpRes.Body.Close()
+
+	if httpRes.StatusCode != 204 && httpRes.StatusCode != 404 {
+		addAPIErrorDiagnostic(&resp.Diagnostics, httpRes)
+		return
+	}
+
+	tflog.Trace(ctx, "deleted a resource")
 }
 
 func (r *DomainResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	organizationId, environmentId, domainId, idErr := ParseDomainID(req.ID)
+	if idErr != nil {
+		resp.Diagnostics.AddError(
+			"Error parsing id",
+			idErr.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("organization_id"), organizationId)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("environment_id"), environmentId)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("domain_id"), domainId)...)
+}
+
+func (r *DomainResource) patchDomain(ctx context.Context, organizationId string, environmentId string, domainId string, patch client.PatchDomain, diags *diag.Diagnostics) (*client.Domain, bool) {
+	httpRes, err := r.client.EnvironmentPatchDomain(ctx, organizationId, environmentId, domainId, patch)
+	if err != nil {
+		diags.AddError(
+			"Unable to update item",
+			err.Error(),
+		)
+		return nil, false
+	}
+	defer httpRes.Body.Close()
+
+	if httpRes.StatusCode != 200 {
+		addAPIErrorDiagnostic(diags, httpRes)
+		return nil, false
+	}
+
+	var apiRes client.Domain
+	if err := json.NewDecoder(httpRes.Body).Decode(&apiRes); err != nil {
+		diags.AddError(
+			"Invalid format received",
+			err.Error(),
+		)
+		return nil, false
+	}
+	return &apiRes, true
 }
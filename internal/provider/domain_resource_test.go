@@ -13,33 +13,37 @@ func TestAccDomainResource(t *testing.T) {
 		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
 		Steps: []resource.TestStep{
 			{
-				Config: providerConfig + testAccDomainResourceConfig("one"),
+				Config: providerConfig + testAccDomainResourceConfig("tf-acc-domain", "one"),
 				Check: resource.ComposeAggregateTestCheckFunc(
-					resource.TestCheckResourceAttr("graviteeioam_domain.test", "configurable_attribute", "one"),
-					resource.TestCheckResourceAttr("graviteeioam_domain.test", "defaulted", "example value when not configured"),
-					resource.TestCheckResourceAttr("graviteeioam_domain.test", "id", "example-id"),
+					resource.TestCheckResourceAttr("graviteeioam_domain.test", "name", "tf-acc-domain"),
+					resource.TestCheckResourceAttr("graviteeioam_domain.test", "description", "one"),
+					resource.TestCheckResourceAttr("graviteeioam_domain.test", "enabled", "false"),
+					resource.TestCheckResourceAttrSet("graviteeioam_domain.test", "domain_id"),
+					resource.TestCheckResourceAttrSet("graviteeioam_domain.test", "path"),
 				),
 			},
 			{
-				ResourceName:            "graviteeioam_domain.test",
-				ImportState:             true,
-				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"configurable_attribute", "defaulted"},
+				ResourceName:      "graviteeioam_domain.test",
+				ImportState:       true,
+				ImportStateVerify: true,
 			},
 			{
-				Config: providerConfig + testAccDomainResourceConfig("two"),
+				Config: providerConfig + testAccDomainResourceConfig("tf-acc-domain", "two"),
 				Check: resource.ComposeAggregateTestCheckFunc(
-					resource.TestCheckResourceAttr("graviteeioam_domain.test", "configurable_attribute", "two"),
+					resource.TestCheckResourceAttr("graviteeioam_domain.test", "description", "two"),
 				),
 			},
 		},
 	})
 }
 
-func testAccDomainResourceConfig(configurableAttribute string) string {
+func testAccDomainResourceConfig(name string, description string) string {
 	return fmt.Sprintf(`
 resource "graviteeioam_domain" "test" {
-  configurable_attribute = %[1]q
+  organization_id = "DEFAULT"
+  environment_id  = "DEFAULT"
+  name            = %[1]q
+  description     = %[2]q
 }
-`, configurableAttribute)
+`, name, description)
 }
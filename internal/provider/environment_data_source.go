@@ -2,8 +2,8 @@ package provider
 
 import (
 	"context"
-	"encoding/json"
 	"fmt"
+	"net/http"
 	"strings"
 
 	"github.com/thornleyk/graviteeioam-service/client"
@@ -76,43 +76,14 @@ func (d *EnvironmentDataSource) Read(ctx context.Context, req datasource.ReadReq
 		return
 	}
 
-	var listParams = client.EnvironmentListDomainsPaginatedParams{}
-
-	httpRes, err := d.client.EnvironmentListDomainsPaginated(ctx, organizationId, environmentId, &listParams)
-	if err != nil {
-		resp.Diagnostics.AddError(
-			"Unable to read item",
-			err.Error(),
-		)
-		return
-	}
-
-	if httpRes.StatusCode != 200 {
-		resp.Diagnostics.AddError(
-			"Invalid format received",
-			httpRes.Status,
-		)
-		return
-	}
-
-	var listWrapper client.Page
-	if listWrapperErr := json.NewDecoder(httpRes.Body).Decode(&listWrapper); err != nil {
-		resp.Diagnostics.AddError(
-			"Invalid list format received",
-			listWrapperErr.Error(),
-		)
-		return
-	}
-
-	dataJSON, err := json.Marshal(listWrapper.Data)
+	apiRes, err := listAllPages[client.Domain](ctx, func(ctx context.Context, page int32, size int32) (*http.Response, error) {
+		return d.client.EnvironmentListDomainsPaginated(ctx, organizationId, environmentId, &client.EnvironmentListDomainsPaginatedParams{
+			Page: &page,
+			Size: &size,
+		})
+	})
 	if err != nil {
-		fmt.Println("Error converting Data to JSON string:", err)
-		return
-	}
-
-	var apiRes []client.Domain
-	if marshalErr := json.Unmarshal(dataJSON, &apiRes); marshalErr != nil {
-		fmt.Println("Error re-marshaling JSON string to concrete type:", marshalErr)
+		addErrorDiagnostic(&resp.Diagnostics, "Unable to read item", err)
 		return
 	}
 
@@ -0,0 +1,73 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccFactorResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + testAccFactorResourceConfig("6"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("graviteeioam_factor.test", "factor_type", "OTP"),
+					resource.TestCheckResourceAttrSet("graviteeioam_factor.test", "factor_id"),
+					resource.TestCheckTypeSetElemAttrPair("graviteeioam_application.test", "factors.*", "graviteeioam_factor.test", "factor_id"),
+				),
+			},
+			{
+				ResourceName:      "graviteeioam_factor.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+				// The imported configuration is normalized by AM.
+				ImportStateVerifyIgnore: []string{"configuration"},
+			},
+			{
+				Config: providerConfig + testAccFactorResourceConfig("8"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("graviteeioam_factor.test", "name", "tf-acc-otp"),
+				),
+			},
+		},
+	})
+}
+
+func testAccFactorResourceConfig(digits string) string {
+	return fmt.Sprintf(`
+resource "graviteeioam_domain" "test" {
+  organization_id = "DEFAULT"
+  environment_id  = "DEFAULT"
+  name            = "tf-acc-factor-domain"
+}
+
+resource "graviteeioam_factor" "test" {
+  organization_id = graviteeioam_domain.test.organization_id
+  environment_id  = graviteeioam_domain.test.environment_id
+  domain_id       = graviteeioam_domain.test.domain_id
+  name            = "tf-acc-otp"
+  type            = "otp-am-factor"
+  factor_type     = "OTP"
+  configuration = jsonencode({
+    issuer       = "tf-acc"
+    algorithm    = "HmacSHA1"
+    timeStep     = "30"
+    returnDigits = %[1]q
+  })
+}
+
+resource "graviteeioam_application" "test" {
+  organization_id = graviteeioam_domain.test.organization_id
+  environment_id  = graviteeioam_domain.test.environment_id
+  domain_id       = graviteeioam_domain.test.domain_id
+  name            = "tf-acc-factor-app"
+  type            = "web"
+  redirect_uris   = ["https://example.com/callback"]
+  factors         = [graviteeioam_factor.test.factor_id]
+}
+`, digits)
+}
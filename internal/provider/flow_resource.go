@@ -0,0 +1,229 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/thornleyk/graviteeioam-service/client"
+	flowModel "github.com/thornleyk/terraform-provider-graviteeioam/internal/model/flow"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var _ resource.Resource = &FlowResource{}
+var _ resource.ResourceWithImportState = &FlowResource{}
+
+func NewFlowResource() resource.Resource {
+	return &FlowResource{}
+}
+
+type FlowResource struct {
+	client *client.Client
+}
+
+// ParseFlowID parses the id of the flows of a domain, or of an application
+// when the application id is not empty.
+func ParseFlowID(id string) (string, string, string, string, error) {
+	parts := strings.Split(id, ":")
+	if (len(parts) != 3 && len(parts) != 4) || containsString(parts, "") {
+		return "", "", "", "", fmt.Errorf("unexpected format of ID (%s), expected organizationId:environmentId:domainId or organizationId:environmentId:domainId:applicationId", id)
+	}
+	if len(parts) == 3 {
+		return parts[0], parts[1], parts[2], "", nil
+	}
+	return parts[0], parts[1], parts[2], parts[3], nil
+}
+
+func (r *FlowResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_flow"
+}
+
+func (r *FlowResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = *flowModel.GetFlowResourceSchema()
+}
+
+func (r *FlowResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *FlowResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data flowModel.FlowResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	flows, ok := r.putFlows(ctx, data, flowModel.NewFlowsFromResource(data), &resp.Diagnostics)
+	if !ok {
+		return
+	}
+
+	data = flowModel.MapFlowResource(flows, data)
+
+	tflog.Trace(ctx, "created a resource")
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *FlowResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data flowModel.FlowResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var httpRes *http.Response
+	var err error
+	if data.ApplicationId.IsNull() {
+		httpRes, err = r.client.EnvironmentListDomainFlows(ctx, data.OrganizationId.ValueString(), data.EnvironmentId.ValueString(), data.DomainId.ValueString())
+	} else {
+		httpRes, err = r.client.ApplicationListFlows(ctx, data.OrganizationId.ValueString(), data.EnvironmentId.ValueString(), data.DomainId.ValueString(), data.ApplicationId.ValueString())
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to read item",
+			err.Error(),
+		)
+		return
+	}
+	defer httpRes.Body.Close()
+
+	if httpRes.StatusCode == 404 {
+		tflog.Warn(ctx, "Flows not found, removing from state", map[string]any{"id": data.Id.ValueString()})
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	if httpRes.StatusCode != 200 {
+		addAPIErrorDiagnostic(&resp.Diagnostics, httpRes)
+		return
+	}
+
+	var apiRes []client.FlowEntity
+	if err := json.NewDecoder(httpRes.Body).Decode(&apiRes); err != nil {
+		resp.Diagnostics.AddError(
+			"Invalid format received",
+			err.Error(),
+		)
+		return
+	}
+
+	data = flowModel.MapFlowResource(apiRes, data)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *FlowResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data flowModel.FlowResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	flows, ok := r.putFlows(ctx, data, flowModel.NewFlowsFromResource(data), &resp.Diagnostics)
+	if !ok {
+		return
+	}
+
+	data = flowModel.MapFlowResource(flows, data)
+
+	tflog.Trace(ctx, "updated a resource")
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *FlowResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data flowModel.FlowResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The flows are replaced as a whole, an empty list deletes them.
+	if _, ok := r.putFlows(ctx, data, []client.Flow{}, &resp.Diagnostics); !ok {
+		return
+	}
+
+	tflog.Trace(ctx, "deleted a resource")
+}
+
+func (r *FlowResource) putFlows(ctx context.Context, data flowModel.FlowResourceModel, flows []client.Flow, diags *diag.Diagnostics) ([]client.FlowEntity, bool) {
+	var httpRes *http.Response
+	var err error
+	if data.ApplicationId.IsNull() {
+		httpRes, err = r.client.EnvironmentCreateOrUpdateDomainFlows(ctx, data.OrganizationId.ValueString(), data.EnvironmentId.ValueString(), data.DomainId.ValueString(), flows)
+	} else {
+		httpRes, err = r.client.ApplicationCreateFlow(ctx, data.OrganizationId.ValueString(), data.EnvironmentId.ValueString(), data.DomainId.ValueString(), data.ApplicationId.ValueString(), flows)
+	}
+	if err != nil {
+		diags.AddError(
+			"Unable to update item",
+			err.Error(),
+		)
+		return nil, false
+	}
+	defer httpRes.Body.Close()
+
+	if httpRes.StatusCode != 200 {
+		addAPIErrorDiagnostic(diags, httpRes)
+		return nil, false
+	}
+
+	var apiRes []client.FlowEntity
+	if err := json.NewDecoder(httpRes.Body).Decode(&apiRes); err != nil {
+		diags.AddError(
+			"Invalid format received",
+			err.Error(),
+		)
+		return nil, false
+	}
+	return apiRes, true
+}
+
+func (r *FlowResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	organizationId, environmentId, domainId, applicationId, idErr := ParseFlowID(req.ID)
+	if idErr != nil {
+		resp.Diagnostics.AddError(
+			"Error parsing id",
+			idErr.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("organization_id"), organizationId)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("environment_id"), environmentId)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("domain_id"), domainId)...)
+	if applicationId != "" {
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("application_id"), applicationId)...)
+	}
+}
@@ -0,0 +1,129 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestParseFlowID(t *testing.T) {
+	organizationId, environmentId, domainId, applicationId, err := ParseFlowID("DEFAULT:DEFAULT:domain")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if organizationId != "DEFAULT" || environmentId != "DEFAULT" || domainId != "domain" || applicationId != "" {
+		t.Errorf("unexpected parts: %s, %s, %s, %s", organizationId, environmentId, domainId, applicationId)
+	}
+
+	_, _, domainId, applicationId, err = ParseFlowID("DEFAULT:DEFAULT:domain:application")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if domainId != "domain" || applicationId != "application" {
+		t.Errorf("unexpected parts: %s, %s", domainId, applicationId)
+	}
+
+	for _, id := range []string{"", "DEFAULT:DEFAULT", "DEFAULT::domain", "DEFAULT:DEFAULT:domain:", "DEFAULT:DEFAULT:domain:application:extra"} {
+		if _, _, _, _, err := ParseFlowID(id); err == nil {
+			t.Errorf("expected an error for %q", id)
+		}
+	}
+}
+
+func TestAccFlowResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + testAccFlowResourceConfig("true"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("graviteeioam_flow.domain", "flows.#", "2"),
+					resource.TestCheckResourceAttr("graviteeioam_flow.domain", "flows.1.pre.0.policy", "policy-am-enrich-profile"),
+					resource.TestCheckResourceAttrSet("graviteeioam_flow.domain", "flows.0.flow_id"),
+					resource.TestCheckResourceAttr("graviteeioam_flow.application", "flows.0.type", "LOGIN"),
+				),
+			},
+			{
+				ResourceName:      "graviteeioam_flow.domain",
+				ImportState:       true,
+				ImportStateVerify: true,
+				// The imported configurations are normalized by AM.
+				ImportStateVerifyIgnore: []string{"flows.1.pre.0.configuration"},
+			},
+			{
+				ResourceName:      "graviteeioam_flow.application",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: providerConfig + testAccFlowResourceConfig("false"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("graviteeioam_flow.domain", "flows.1.pre.0.enabled", "false"),
+				),
+			},
+		},
+	})
+}
+
+func testAccFlowResourceConfig(enabled string) string {
+	return fmt.Sprintf(`
+resource "graviteeioam_domain" "test" {
+  organization_id = "DEFAULT"
+  environment_id  = "DEFAULT"
+  name            = "tf-acc-flow-domain"
+}
+
+resource "graviteeioam_application" "test" {
+  organization_id = graviteeioam_domain.test.organization_id
+  environment_id  = graviteeioam_domain.test.environment_id
+  domain_id       = graviteeioam_domain.test.domain_id
+  name            = "tf-acc-flow-app"
+  type            = "web"
+  redirect_uris   = ["https://example.com/callback"]
+}
+
+resource "graviteeioam_flow" "domain" {
+  organization_id = graviteeioam_domain.test.organization_id
+  environment_id  = graviteeioam_domain.test.environment_id
+  domain_id       = graviteeioam_domain.test.domain_id
+
+  flows = [
+    {
+      name = "ALL"
+      type = "ROOT"
+    },
+    {
+      name = "LOGIN"
+      type = "LOGIN"
+      pre = [
+        {
+          policy  = "policy-am-enrich-profile"
+          name    = "Enrich profile"
+          enabled = %[1]s
+          configuration = jsonencode({
+            properties = [{ claim = "tf-acc", claimValue = "true" }]
+          })
+        },
+      ]
+    },
+  ]
+}
+
+resource "graviteeioam_flow" "application" {
+  organization_id = graviteeioam_domain.test.organization_id
+  environment_id  = graviteeioam_domain.test.environment_id
+  domain_id       = graviteeioam_domain.test.domain_id
+  application_id  = graviteeioam_application.test.application_id
+
+  flows = [
+    {
+      name      = "LOGIN"
+      type      = "LOGIN"
+      condition = "{#request.params['tf-acc'] != null}"
+    },
+  ]
+}
+`, enabled)
+}
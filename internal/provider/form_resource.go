@@ -0,0 +1,275 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/thornleyk/graviteeioam-service/client"
+	formModel "github.com/thornleyk/terraform-provider-graviteeioam/internal/model/form"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var _ resource.Resource = &FormResource{}
+var _ resource.ResourceWithImportState = &FormResource{}
+
+func NewFormResource() resource.Resource {
+	return &FormResource{}
+}
+
+type FormResource struct {
+	client *client.Client
+}
+
+// ParseFormID parses the id of the form of a domain, or of an application
+// when the application id is not empty.
+func ParseFormID(id string) (string, string, string, string, string, error) {
+	parts := strings.Split(id, ":")
+	if (len(parts) != 4 && len(parts) != 5) || containsString(parts, "") {
+		return "", "", "", "", "", fmt.Errorf("unexpected format of ID (%s), expected organizationId:environmentId:domainId:template or organizationId:environmentId:domainId:applicationId:template", id)
+	}
+	if len(parts) == 4 {
+		return parts[0], parts[1], parts[2], "", parts[3], nil
+	}
+	return parts[0], parts[1], parts[2], parts[3], parts[4], nil
+}
+
+func (r *FormResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_form"
+}
+
+func (r *FormResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = *formModel.GetFormResourceSchema()
+}
+
+func (r *FormResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *FormResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data formModel.FormResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var httpRes *http.Response
+	var err error
+	if data.ApplicationId.IsNull() {
+		httpRes, err = r.client.EnvironmentCreateDomainForm(ctx, data.OrganizationId.ValueString(), data.EnvironmentId.ValueString(), data.DomainId.ValueString(), formModel.NewFormFromResource(data))
+	} else {
+		httpRes, err = r.client.ApplicationCreateForm(ctx, data.OrganizationId.ValueString(), data.EnvironmentId.ValueString(), data.DomainId.ValueString(), data.ApplicationId.ValueString(), formModel.NewFormFromResource(data))
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to create item",
+			err.Error(),
+		)
+		return
+	}
+	defer httpRes.Body.Close()
+
+	if httpRes.StatusCode != 201 {
+		addAPIErrorDiagnostic(&resp.Diagnostics, httpRes)
+		return
+	}
+
+	var apiRes client.Form
+	if err := json.NewDecoder(httpRes.Body).Decode(&apiRes); err != nil {
+		resp.Diagnostics.AddError(
+			"Invalid format received",
+			err.Error(),
+		)
+		return
+	}
+
+	data = formModel.MapFormResource(&apiRes, data)
+
+	tflog.Trace(ctx, "created a resource")
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *FormResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data formModel.FormResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// There is no endpoint to get a form, the form of a template is listed.
+	var httpRes *http.Response
+	var err error
+	if data.ApplicationId.IsNull() {
+		httpRes, err = r.client.EnvironmentListDomainForms(ctx, data.OrganizationId.ValueString(), data.EnvironmentId.ValueString(), data.DomainId.ValueString(), &client.EnvironmentListDomainFormsParams{
+			Template: client.EnvironmentListDomainFormsParamsTemplate(data.Template.ValueString()),
+		})
+	} else {
+		httpRes, err = r.client.ApplicationListForms(ctx, data.OrganizationId.ValueString(), data.EnvironmentId.ValueString(), data.DomainId.ValueString(), data.ApplicationId.ValueString(), &client.ApplicationListFormsParams{
+			Template: client.ApplicationListFormsParamsTemplate(data.Template.ValueString()),
+		})
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to read item",
+			err.Error(),
+		)
+		return
+	}
+	defer httpRes.Body.Close()
+
+	if httpRes.StatusCode == 404 {
+		tflog.Warn(ctx, "Form not found, removing from state", map[string]any{"id": data.Id.ValueString()})
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	if httpRes.StatusCode != 200 {
+		addAPIErrorDiagnostic(&resp.Diagnostics, httpRes)
+		return
+	}
+
+	var apiRes client.Form
+	if err := json.NewDecoder(httpRes.Body).Decode(&apiRes); err != nil {
+		resp.Diagnostics.AddError(
+			"Invalid format received",
+			err.Error(),
+		)
+		return
+	}
+
+	// AM returns the default template, without an id, when the form was
+	// deleted.
+	if apiRes.Id == nil || (!data.FormId.IsNull() && *apiRes.Id != data.FormId.ValueString()) {
+		tflog.Warn(ctx, "Form not found, removing from state", map[string]any{"id": data.Id.ValueString()})
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	data = formModel.MapFormResource(&apiRes, data)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *FormResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data formModel.FormResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var httpRes *http.Response
+	var err error
+	if data.ApplicationId.IsNull() {
+		httpRes, err = r.client.DomainUpdateForm(ctx, data.OrganizationId.ValueString(), data.EnvironmentId.ValueString(), data.DomainId.ValueString(), data.FormId.ValueString(), formModel.UpdateFormFromResource(data))
+	} else {
+		httpRes, err = r.client.ApplicationUpdateForm(ctx, data.OrganizationId.ValueString(), data.EnvironmentId.ValueString(), data.DomainId.ValueString(), data.ApplicationId.ValueString(), data.FormId.ValueString(), formModel.UpdateFormFromResource(data))
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to update item",
+			err.Error(),
+		)
+		return
+	}
+	defer httpRes.Body.Close()
+
+	if !isUpdateSuccess(httpRes) {
+		addAPIErrorDiagnostic(&resp.Diagnostics, httpRes)
+		return
+	}
+
+	var apiRes client.Form
+	if err := json.NewDecoder(httpRes.Body).Decode(&apiRes); err != nil {
+		resp.Diagnostics.AddError(
+			"Invalid format received",
+			err.Error(),
+		)
+		return
+	}
+
+	data = formModel.MapFormResource(&apiRes, data)
+
+	tflog.Trace(ctx, "updated a resource")
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *FormResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data formModel.FormResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var httpRes *http.Response
+	var err error
+	if data.ApplicationId.IsNull() {
+		httpRes, err = r.client.DomainDeleteForm(ctx, data.OrganizationId.ValueString(), data.EnvironmentId.ValueString(), data.DomainId.ValueString(), data.FormId.ValueString())
+	} else {
+		httpRes, err = r.client.ApplicationDeleteForm(ctx, data.OrganizationId.ValueString(), data.EnvironmentId.ValueString(), data.DomainId.ValueString(), data.ApplicationId.ValueString(), data.FormId.ValueString())
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to delete item",
+			err.Error(),
+		)
+		return
+	}
+	defer httpRes.Body.Close()
+
+	if httpRes.StatusCode != 204 && httpRes.StatusCode != 404 {
+		addAPIErrorDiagnostic(&resp.Diagnostics, httpRes)
+		return
+	}
+
+	tflog.Trace(ctx, "deleted a resource")
+}
+
+func (r *FormResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	organizationId, environmentId, domainId, applicationId, template, idErr := ParseFormID(req.ID)
+	if idErr != nil {
+		resp.Diagnostics.AddError(
+			"Error parsing id",
+			idErr.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("organization_id"), organizationId)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("environment_id"), environmentId)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("domain_id"), domainId)...)
+	if applicationId != "" {
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("application_id"), applicationId)...)
+	}
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("template"), template)...)
+}
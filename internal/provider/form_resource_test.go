@@ -0,0 +1,108 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestParseFormID(t *testing.T) {
+	organizationId, environmentId, domainId, applicationId, template, err := ParseFormID("DEFAULT:DEFAULT:domain:LOGIN")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if organizationId != "DEFAULT" || environmentId != "DEFAULT" || domainId != "domain" || applicationId != "" || template != "LOGIN" {
+		t.Errorf("unexpected parts: %s, %s, %s, %s, %s", organizationId, environmentId, domainId, applicationId, template)
+	}
+
+	_, _, _, applicationId, template, err = ParseFormID("DEFAULT:DEFAULT:domain:application:REGISTRATION")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if applicationId != "application" || template != "REGISTRATION" {
+		t.Errorf("unexpected parts: %s, %s", applicationId, template)
+	}
+
+	for _, id := range []string{"", "DEFAULT:DEFAULT:domain", "DEFAULT:DEFAULT::LOGIN", "DEFAULT:DEFAULT:domain:application:LOGIN:extra"} {
+		if _, _, _, _, _, err := ParseFormID(id); err == nil {
+			t.Errorf("expected an error for %q", id)
+		}
+	}
+}
+
+func TestAccFormResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + testAccFormResourceConfig("Sign in"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("graviteeioam_form.domain", "template", "LOGIN"),
+					resource.TestCheckResourceAttrSet("graviteeioam_form.domain", "form_id"),
+					resource.TestCheckResourceAttr("graviteeioam_form.application", "template", "REGISTRATION"),
+				),
+			},
+			{
+				ResourceName:      "graviteeioam_form.domain",
+				ImportState:       true,
+				ImportStateVerify: true,
+				// AM trims the trailing whitespace of the imported content.
+				ImportStateVerifyIgnore: []string{"content"},
+			},
+			{
+				ResourceName:            "graviteeioam_form.application",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"content"},
+			},
+			{
+				Config: providerConfig + testAccFormResourceConfig("Log in"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("graviteeioam_form.domain", "content", "<html>\n  <body>Log in</body>\n</html>\n"),
+				),
+			},
+		},
+	})
+}
+
+func testAccFormResourceConfig(title string) string {
+	return fmt.Sprintf(`
+resource "graviteeioam_domain" "test" {
+  organization_id = "DEFAULT"
+  environment_id  = "DEFAULT"
+  name            = "tf-acc-form-domain"
+}
+
+resource "graviteeioam_application" "test" {
+  organization_id = graviteeioam_domain.test.organization_id
+  environment_id  = graviteeioam_domain.test.environment_id
+  domain_id       = graviteeioam_domain.test.domain_id
+  name            = "tf-acc-form-app"
+  type            = "web"
+  redirect_uris   = ["https://example.com/callback"]
+}
+
+resource "graviteeioam_form" "domain" {
+  organization_id = graviteeioam_domain.test.organization_id
+  environment_id  = graviteeioam_domain.test.environment_id
+  domain_id       = graviteeioam_domain.test.domain_id
+  template        = "LOGIN"
+  content         = <<-EOT
+    <html>
+      <body>%[1]s</body>
+    </html>
+  EOT
+}
+
+resource "graviteeioam_form" "application" {
+  organization_id = graviteeioam_domain.test.organization_id
+  environment_id  = graviteeioam_domain.test.environment_id
+  domain_id       = graviteeioam_domain.test.domain_id
+  application_id  = graviteeioam_application.test.application_id
+  template        = "REGISTRATION"
+  content         = "<html><body>Register</body></html>"
+}
+`, title)
+}
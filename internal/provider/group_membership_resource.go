@@ -0,0 +1,272 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/thornleyk/graviteeioam-service/client"
+	"github.com/thornleyk/terraform-provider-graviteeioam/internal/model/convert"
+	groupModel "github.com/thornleyk/terraform-provider-graviteeioam/internal/model/group"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var _ resource.Resource = &GroupMembershipResource{}
+var _ resource.ResourceWithImportState = &GroupMembershipResource{}
+var _ resource.ResourceWithValidateConfig = &GroupMembershipResource{}
+
+func NewGroupMembershipResource() resource.Resource {
+	return &GroupMembershipResource{}
+}
+
+type GroupMembershipResource struct {
+	client *client.Client
+}
+
+func (r *GroupMembershipResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_group_membership"
+}
+
+func (r *GroupMembershipResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = *groupModel.GetGroupMembershipResourceSchema()
+}
+
+func (r *GroupMembershipResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *GroupMembershipResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data groupModel.GroupMembershipResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	validateReference("groups", data.ReferenceType, data.EnvironmentId, data.DomainId, &resp.Diagnostics)
+}
+
+func (r *GroupMembershipResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data groupModel.GroupMembershipResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	members, diags := convert.OptionalStrings(ctx, data.Members)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	added, _ := groupModel.MembersDiff(nil, *members)
+	r.applyMembers(ctx, data, added, nil, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.readMembers(ctx, data, *members, &resp.State, &resp.Diagnostics)
+
+	tflog.Trace(ctx, "created a resource")
+}
+
+func (r *GroupMembershipResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data groupModel.GroupMembershipResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The members are unknown after an import, every current member is
+	// adopted.
+	managed, diags := convert.OptionalStrings(ctx, data.Members)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var managedMembers []string
+	if managed != nil {
+		managedMembers = *managed
+	}
+	r.readMembers(ctx, data, managedMembers, &resp.State, &resp.Diagnostics)
+}
+
+func (r *GroupMembershipResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data, state groupModel.GroupMembershipResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	planned, diags := convert.OptionalStrings(ctx, data.Members)
+	resp.Diagnostics.Append(diags...)
+	prior, diags := convert.OptionalStrings(ctx, state.Members)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	added, removed := groupModel.MembersDiff(*prior, *planned)
+	r.applyMembers(ctx, data, added, removed, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.readMembers(ctx, data, *planned, &resp.State, &resp.Diagnostics)
+
+	tflog.Trace(ctx, "updated a resource")
+}
+
+func (r *GroupMembershipResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data groupModel.GroupMembershipResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	members, diags := convert.OptionalStrings(ctx, data.Members)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() || members == nil {
+		return
+	}
+
+	_, removed := groupModel.MembersDiff(*members, nil)
+	r.applyMembers(ctx, data, nil, removed, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Trace(ctx, "deleted a resource")
+}
+
+func (r *GroupMembershipResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	referenceType, organizationId, environmentId, domainId, groupId, idErr := ParseGroupID(req.ID)
+	if idErr != nil {
+		resp.Diagnostics.AddError(
+			"Error parsing id",
+			idErr.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("reference_type"), referenceType)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("organization_id"), organizationId)...)
+	if referenceType == "domain" {
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("environment_id"), environmentId)...)
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("domain_id"), domainId)...)
+	}
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("group_id"), groupId)...)
+}
+
+// readMembers saves the managed members that still belong to the group,
+// every member when managed is nil. The resource is removed from state when
+// the group no longer exists.
+func (r *GroupMembershipResource) readMembers(ctx context.Context, data groupModel.GroupMembershipResourceModel, managed []string, state *tfsdk.State, diags *diag.Diagnostics) {
+	reference := groupReference{
+		referenceType:  data.ReferenceType.ValueString(),
+		organizationId: data.OrganizationId.ValueString(),
+		environmentId:  data.EnvironmentId.ValueString(),
+		domainId:       data.DomainId.ValueString(),
+		groupId:        data.GroupId.ValueString(),
+	}
+
+	apiRes, ok := readGroup(ctx, r.client, reference, diags)
+	if !ok {
+		return
+	}
+
+	if apiRes == nil {
+		tflog.Warn(ctx, "Group not found, removing from state", map[string]any{"id": data.Id.ValueString()})
+		state.RemoveResource(ctx)
+		return
+	}
+
+	data = groupModel.MapGroupMembershipResource(apiRes, managed, data)
+
+	diags.Append(state.Set(ctx, &data)...)
+}
+
+// applyMembers adds and removes members of a group one at a time, the other
+// members are left untouched.
+func (r *GroupMembershipResource) applyMembers(ctx context.Context, data groupModel.GroupMembershipResourceModel, added []string, removed []string, diags *diag.Diagnostics) {
+	groupsMutex.Lock()
+	defer groupsMutex.Unlock()
+
+	organizationId := data.OrganizationId.ValueString()
+	environmentId := data.EnvironmentId.ValueString()
+	domainId := data.DomainId.ValueString()
+	groupId := data.GroupId.ValueString()
+	domainGroup := data.ReferenceType.ValueString() == "domain"
+
+	for _, member := range added {
+		var httpRes *http.Response
+		var err error
+		if domainGroup {
+			httpRes, err = r.client.DomainAddOrUpdateGroupMemeber(ctx, organizationId, environmentId, domainId, groupId, member)
+		} else {
+			httpRes, err = r.client.OrganizationAddPlatformGroupMember(ctx, organizationId, groupId, member)
+		}
+		if !checkMemberResponse(httpRes, err, "Unable to add group member", diags) {
+			return
+		}
+	}
+
+	for _, member := range removed {
+		var httpRes *http.Response
+		var err error
+		if domainGroup {
+			httpRes, err = r.client.EnvironmentRemoveDomainGroupMemeber(ctx, organizationId, environmentId, domainId, groupId, member)
+		} else {
+			httpRes, err = r.client.OrganizationRemovePlatformGroupMember(ctx, organizationId, groupId, member)
+		}
+		if !checkMemberResponse(httpRes, err, "Unable to remove group member", diags) {
+			return
+		}
+	}
+}
+
+func checkMemberResponse(httpRes *http.Response, err error, summary string, diags *diag.Diagnostics) bool {
+	if err != nil {
+		diags.AddError(summary, err.Error())
+		return false
+	}
+	defer httpRes.Body.Close()
+
+	if httpRes.StatusCode != 200 && httpRes.StatusCode != 204 {
+		addAPIErrorDiagnostic(diags, httpRes)
+		return false
+	}
+	return true
+}
@@ -0,0 +1,47 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccGroupMembershipResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + testAccUserResourceConfig("John", true) + testAccGroupMembershipResourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("graviteeioam_group_membership.test", "members.#", "1"),
+					resource.TestCheckTypeSetElemAttrPair("graviteeioam_group_membership.test", "members.*", "graviteeioam_user.test", "user_id"),
+				),
+			},
+			{
+				ResourceName:      "graviteeioam_group_membership.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+const testAccGroupMembershipResourceConfig = `
+resource "graviteeioam_group" "test" {
+  reference_type  = "domain"
+  organization_id = graviteeioam_domain.test.organization_id
+  environment_id  = graviteeioam_domain.test.environment_id
+  domain_id       = graviteeioam_domain.test.domain_id
+  name            = "tf-acc-membership"
+}
+
+resource "graviteeioam_group_membership" "test" {
+  reference_type  = graviteeioam_group.test.reference_type
+  organization_id = graviteeioam_group.test.organization_id
+  environment_id  = graviteeioam_group.test.environment_id
+  domain_id       = graviteeioam_group.test.domain_id
+  group_id        = graviteeioam_group.test.group_id
+  members         = [graviteeioam_user.test.user_id]
+}
+`
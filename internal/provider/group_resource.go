@@ -0,0 +1,359 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sync"
+
+	"github.com/thornleyk/graviteeioam-service/client"
+	groupModel "github.com/thornleyk/terraform-provider-graviteeioam/internal/model/group"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var _ resource.Resource = &GroupResource{}
+var _ resource.ResourceWithImportState = &GroupResource{}
+var _ resource.ResourceWithValidateConfig = &GroupResource{}
+
+// groupsMutex serializes the read-modify-write updates of a group with the
+// changes of its members, shared by the group and group membership
+// resources.
+var groupsMutex sync.Mutex
+
+func NewGroupResource() resource.Resource {
+	return &GroupResource{}
+}
+
+type GroupResource struct {
+	client *client.Client
+}
+
+// groupReference identifies a group of a domain or of an organization.
+type groupReference struct {
+	referenceType  string
+	organizationId string
+	environmentId  string
+	domainId       string
+	groupId        string
+}
+
+// ParseGroupID parses the id of a domain group, organizationId:environmentId:domainId:groupId,
+// or of an organization group, organizationId:groupId, and returns its reference type.
+func ParseGroupID(id string) (string, string, string, string, string, error) {
+	return parseReferenceID(id, "groupId")
+}
+
+func (r *GroupResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_group"
+}
+
+func (r *GroupResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = *groupModel.GetGroupResourceSchema()
+}
+
+func (r *GroupResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *GroupResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data groupModel.GroupResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	validateReference("groups", data.ReferenceType, data.EnvironmentId, data.DomainId, &resp.Diagnostics)
+}
+
+func (r *GroupResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data groupModel.GroupResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	newGroup := groupModel.NewGroupFromResource(data)
+
+	var httpRes *http.Response
+	var err error
+	if data.ReferenceType.ValueString() == "domain" {
+		httpRes, err = r.client.EnvironmentCreateDomainGroup(ctx, data.OrganizationId.ValueString(), data.EnvironmentId.ValueString(), data.DomainId.ValueString(), newGroup)
+	} else {
+		httpRes, err = r.client.OrganizationCreatePlatformGroup(ctx, data.OrganizationId.ValueString(), newGroup)
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to create item",
+			err.Error(),
+		)
+		return
+	}
+	defer httpRes.Body.Close()
+
+	if httpRes.StatusCode != 201 {
+		addAPIErrorDiagnostic(&resp.Diagnostics, httpRes)
+		return
+	}
+
+	var apiRes client.Group
+	if err := json.NewDecoder(httpRes.Body).Decode(&apiRes); err != nil {
+		resp.Diagnostics.AddError(
+			"Invalid format received",
+			err.Error(),
+		)
+		return
+	}
+
+	// The create endpoint does not accept the roles, they are applied with a
+	// follow-up update.
+	if !data.Roles.IsUnknown() && !data.Roles.IsNull() {
+		update, diags := groupModel.UpdateGroupFromResource(ctx, data, &apiRes)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		updated, ok := updateGroup(ctx, r.client, groupReferenceOf(data, *apiRes.Id), update, &resp.Diagnostics)
+		if !ok {
+			// Keep the created group in state so that it is not orphaned.
+			data = groupModel.MapGroupResource(&apiRes, data)
+			resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+			return
+		}
+		apiRes = *updated
+	}
+
+	data = groupModel.MapGroupResource(&apiRes, data)
+
+	tflog.Trace(ctx, "created a resource")
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *GroupResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data groupModel.GroupResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	apiRes, ok := readGroup(ctx, r.client, groupReferenceOf(data, data.GroupId.ValueString()), &resp.Diagnostics)
+	if !ok {
+		return
+	}
+
+	if apiRes == nil {
+		tflog.Warn(ctx, "Group not found, removing from state", map[string]any{"id": data.Id.ValueString()})
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	data = groupModel.MapGroupResource(apiRes, data)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *GroupResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data groupModel.GroupResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	reference := groupReferenceOf(data, data.GroupId.ValueString())
+
+	groupsMutex.Lock()
+	defer groupsMutex.Unlock()
+
+	// The update replaces the members, the current ones are sent back.
+	current, ok := readGroup(ctx, r.client, reference, &resp.Diagnostics)
+	if !ok {
+		return
+	}
+	if current == nil {
+		resp.Diagnostics.AddError(
+			"Unable to update item",
+			fmt.Sprintf("Group %s not found", data.Id.ValueString()),
+		)
+		return
+	}
+
+	update, diags := groupModel.UpdateGroupFromResource(ctx, data, current)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	apiRes, ok := updateGroup(ctx, r.client, reference, update, &resp.Diagnostics)
+	if !ok {
+		return
+	}
+
+	data = groupModel.MapGroupResource(apiRes, data)
+
+	tflog.Trace(ctx, "updated a resource")
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *GroupResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data groupModel.GroupResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var httpRes *http.Response
+	var err error
+	if data.ReferenceType.ValueString() == "domain" {
+		httpRes, err = r.client.DomainDeleteGroup(ctx, data.OrganizationId.ValueString(), data.EnvironmentId.ValueString(), data.DomainId.ValueString(), data.GroupId.ValueString())
+	} else {
+		httpRes, err = r.client.OrganizationDeletePlatformGroup(ctx, data.OrganizationId.ValueString(), data.GroupId.ValueString())
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to delete item",
+			err.Error(),
+		)
+		return
+	}
+	defer httpRes.Body.Close()
+
+	if httpRes.StatusCode != 204 && httpRes.StatusCode != 404 {
+		addAPIErrorDiagnostic(&resp.Diagnostics, httpRes)
+		return
+	}
+
+	tflog.Trace(ctx, "deleted a resource")
+}
+
+func (r *GroupResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	referenceType, organizationId, environmentId, domainId, groupId, idErr := ParseGroupID(req.ID)
+	if idErr != nil {
+		resp.Diagnostics.AddError(
+			"Error parsing id",
+			idErr.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("reference_type"), referenceType)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("organization_id"), organizationId)...)
+	if referenceType == "domain" {
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("environment_id"), environmentId)...)
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("domain_id"), domainId)...)
+	}
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("group_id"), groupId)...)
+}
+
+func groupReferenceOf(data groupModel.GroupResourceModel, groupId string) groupReference {
+	return groupReference{
+		referenceType:  data.ReferenceType.ValueString(),
+		organizationId: data.OrganizationId.ValueString(),
+		environmentId:  data.EnvironmentId.ValueString(),
+		domainId:       data.DomainId.ValueString(),
+		groupId:        groupId,
+	}
+}
+
+// readGroup returns a group, nil when it does not exist.
+func readGroup(ctx context.Context, c *client.Client, reference groupReference, diags *diag.Diagnostics) (*client.Group, bool) {
+	var httpRes *http.Response
+	var err error
+	if reference.referenceType == "domain" {
+		httpRes, err = c.DomainGetGroup(ctx, reference.organizationId, reference.environmentId, reference.domainId, reference.groupId)
+	} else {
+		httpRes, err = c.OrganizationGetPlatformGroup(ctx, reference.organizationId, reference.groupId)
+	}
+	if err != nil {
+		diags.AddError(
+			"Unable to read item",
+			err.Error(),
+		)
+		return nil, false
+	}
+	defer httpRes.Body.Close()
+
+	if httpRes.StatusCode == 404 {
+		return nil, true
+	}
+
+	if httpRes.StatusCode != 200 {
+		addAPIErrorDiagnostic(diags, httpRes)
+		return nil, false
+	}
+
+	var apiRes client.Group
+	if err := json.NewDecoder(httpRes.Body).Decode(&apiRes); err != nil {
+		diags.AddError(
+			"Invalid format received",
+			err.Error(),
+		)
+		return nil, false
+	}
+	return &apiRes, true
+}
+
+func updateGroup(ctx context.Context, c *client.Client, reference groupReference, update client.UpdateGroup, diags *diag.Diagnostics) (*client.Group, bool) {
+	var httpRes *http.Response
+	var err error
+	if reference.referenceType == "domain" {
+		httpRes, err = c.DomainUpdateGroup(ctx, reference.organizationId, reference.environmentId, reference.domainId, reference.groupId, update)
+	} else {
+		httpRes, err = c.OrganizationUpdatePlatformGroup(ctx, reference.organizationId, reference.groupId, update)
+	}
+	if err != nil {
+		diags.AddError(
+			"Unable to update item",
+			err.Error(),
+		)
+		return nil, false
+	}
+	defer httpRes.Body.Close()
+
+	if !isUpdateSuccess(httpRes) {
+		addAPIErrorDiagnostic(diags, httpRes)
+		return nil, false
+	}
+
+	var apiRes client.Group
+	if err := json.NewDecoder(httpRes.Body).Decode(&apiRes); err != nil {
+		diags.AddError(
+			"Invalid format received",
+			err.Error(),
+		)
+		return nil, false
+	}
+	return &apiRes, true
+}
@@ -0,0 +1,86 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestParseGroupID(t *testing.T) {
+	referenceType, organizationId, environmentId, domainId, groupId, err := ParseGroupID("DEFAULT:DEFAULT:domain:group")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if referenceType != "domain" || organizationId != "DEFAULT" || environmentId != "DEFAULT" || domainId != "domain" || groupId != "group" {
+		t.Errorf("unexpected parts: %s, %s, %s, %s, %s", referenceType, organizationId, environmentId, domainId, groupId)
+	}
+
+	referenceType, _, _, _, groupId, err = ParseGroupID("DEFAULT:group")
+	if err != nil || referenceType != "organization" || groupId != "group" {
+		t.Errorf("unexpected parts: %s, %s, %v", referenceType, groupId, err)
+	}
+
+	for _, id := range []string{"", "DEFAULT", "DEFAULT:DEFAULT:domain", ":group"} {
+		if _, _, _, _, _, err := ParseGroupID(id); err == nil {
+			t.Errorf("expected an error for %q", id)
+		}
+	}
+}
+
+func TestAccGroupResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + testAccGroupResourceConfig("Support"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("graviteeioam_group.test", "name", "Support"),
+					resource.TestCheckResourceAttr("graviteeioam_group.test", "roles.#", "1"),
+					resource.TestCheckResourceAttrPair("graviteeioam_group.test", "roles.0", "graviteeioam_role.test", "role_id"),
+					resource.TestCheckResourceAttrSet("graviteeioam_group.test", "group_id"),
+				),
+			},
+			{
+				ResourceName:      "graviteeioam_group.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: providerConfig + testAccGroupResourceConfig("Customer support"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("graviteeioam_group.test", "name", "Customer support"),
+				),
+			},
+		},
+	})
+}
+
+func testAccGroupResourceConfig(name string) string {
+	return fmt.Sprintf(`
+resource "graviteeioam_domain" "test" {
+  organization_id = "DEFAULT"
+  environment_id  = "DEFAULT"
+  name            = "tf-acc-group-domain"
+}
+
+resource "graviteeioam_role" "test" {
+  reference_type  = "domain"
+  organization_id = graviteeioam_domain.test.organization_id
+  environment_id  = graviteeioam_domain.test.environment_id
+  domain_id       = graviteeioam_domain.test.domain_id
+  name            = "tf-acc-group-role"
+}
+
+resource "graviteeioam_group" "test" {
+  reference_type  = "domain"
+  organization_id = graviteeioam_domain.test.organization_id
+  environment_id  = graviteeioam_domain.test.environment_id
+  domain_id       = graviteeioam_domain.test.domain_id
+  name            = %[1]q
+  description     = "Support team"
+  roles           = [graviteeioam_role.test.role_id]
+}
+`, name)
+}
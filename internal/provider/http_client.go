@@ -0,0 +1,71 @@
+package provider
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"net/http"
+	"os"
+)
+
+// providerTLSConfig holds the TLS settings of the management endpoint.
+type providerTLSConfig struct {
+	CACertPEM          string
+	CACertFile         string
+	ClientCertPEM      string
+	ClientKeyPEM       string
+	InsecureSkipVerify bool
+}
+
+// newTLSConfig builds the tls.Config used to reach the management endpoint.
+// Custom CA certificates are added on top of the system pool.
+func newTLSConfig(config providerTLSConfig) (*tls.Config, error) {
+	tlsConfig := &tls.Config{
+		MinVersion:         tls.VersionTLS12,
+		InsecureSkipVerify: config.InsecureSkipVerify,
+	}
+
+	if config.CACertPEM != "" || config.CACertFile != "" {
+		pool, err := x509.SystemCertPool()
+		if err != nil || pool == nil {
+			pool = x509.NewCertPool()
+		}
+		if config.CACertPEM != "" && !pool.AppendCertsFromPEM([]byte(config.CACertPEM)) {
+			return nil, fmt.Errorf("no valid PEM certificate found in ca_cert_pem")
+		}
+		if config.CACertFile != "" {
+			caCert, err := os.ReadFile(config.CACertFile)
+			if err != nil {
+				return nil, fmt.Errorf("unable to read ca_cert_file: %w", err)
+			}
+			if !pool.AppendCertsFromPEM(caCert) {
+				return nil, fmt.Errorf("no valid PEM certificate found in ca_cert_file %s", config.CACertFile)
+			}
+		}
+		tlsConfig.RootCAs = pool
+	}
+
+	if config.ClientCertPEM != "" || config.ClientKeyPEM != "" {
+		if config.ClientCertPEM == "" || config.ClientKeyPEM == "" {
+			return nil, fmt.Errorf("client_cert_pem and client_key_pem must be set together")
+		}
+		certificate, err := tls.X509KeyPair([]byte(config.ClientCertPEM), []byte(config.ClientKeyPEM))
+		if err != nil {
+			return nil, fmt.Errorf("invalid client certificate or key: %w", err)
+		}
+		tlsConfig.Certificates = []tls.Certificate{certificate}
+	}
+
+	return tlsConfig, nil
+}
+
+// newHTTPClient returns the HTTP client shared by the authentication and
+// management API clients.
+func newHTTPClient(tlsConfig *tls.Config, retryConfig providerRetryConfig) *http.Client {
+	transport := &http.Transport{Proxy: http.ProxyFromEnvironment}
+	if defaultTransport, ok := http.DefaultTransport.(*http.Transport); ok {
+		transport = defaultTransport.Clone()
+	}
+	transport.TLSClientConfig = tlsConfig
+	return &http.Client{Transport: newRetryTransport(transport, retryConfig)}
+}
@@ -0,0 +1,61 @@
+package provider
+
+import (
+	"encoding/pem"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestNewHTTPClientTrustsCustomCA(t *testing.T) {
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	caPEM := string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: server.Certificate().Raw}))
+
+	untrusted, err := newTLSConfig(providerTLSConfig{})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if _, err := newHTTPClient(untrusted, providerRetryConfig{}).Get(server.URL); err == nil {
+		t.Fatal("expected the self-signed certificate to be rejected")
+	}
+
+	caFile := filepath.Join(t.TempDir(), "ca.pem")
+	if err := os.WriteFile(caFile, []byte(caPEM), 0o600); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	for name, settings := range map[string]providerTLSConfig{
+		"ca_cert_pem":          {CACertPEM: caPEM},
+		"ca_cert_file":         {CACertFile: caFile},
+		"insecure_skip_verify": {InsecureSkipVerify: true},
+	} {
+		tlsConfig, err := newTLSConfig(settings)
+		if err != nil {
+			t.Fatalf("%s: unexpected error: %s", name, err)
+		}
+		res, err := newHTTPClient(tlsConfig, providerRetryConfig{}).Get(server.URL)
+		if err != nil {
+			t.Fatalf("%s: unexpected error: %s", name, err)
+		}
+		res.Body.Close()
+	}
+}
+
+func TestNewTLSConfigRejectsInvalidSettings(t *testing.T) {
+	for name, settings := range map[string]providerTLSConfig{
+		"invalid ca_cert_pem":    {CACertPEM: "not a certificate"},
+		"missing ca_cert_file":   {CACertFile: filepath.Join(t.TempDir(), "missing.pem")},
+		"client cert alone":      {ClientCertPEM: "cert"},
+		"invalid client keypair": {ClientCertPEM: "cert", ClientKeyPEM: "key"},
+	} {
+		if _, err := newTLSConfig(settings); err == nil {
+			t.Errorf("%s: expected an error", name)
+		}
+	}
+}
@@ -67,12 +67,9 @@ func (d *OrganizationDataSource) Read(ctx context.Context, req datasource.ReadRe
 		return
 	}
 
-	var apiRes client.Organization
+	var apiRes organizationModel.Organization
 	if httpRes.StatusCode != 200 {
-		resp.Diagnostics.AddError(
-			"Unexpected HTTP error code received",
-			httpRes.Status,
-		)
+		addAPIErrorDiagnostic(&resp.Diagnostics, httpRes)
 		return
 	}
 
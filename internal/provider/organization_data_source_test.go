@@ -15,6 +15,7 @@ func TestAccOrganizationDataSource(t *testing.T) {
 				Config: providerConfig + testAccOrganizationDataSourceConfig,
 				Check: resource.ComposeAggregateTestCheckFunc(
 					resource.TestCheckResourceAttr("data.graviteeioam_organization.test", "id", "DEFAULT"),
+					resource.TestCheckResourceAttrSet("data.graviteeioam_organization.test", "name"),
 				),
 			},
 		},
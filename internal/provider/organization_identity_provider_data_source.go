@@ -51,8 +51,8 @@ func (d *OrganizationIdentityProviderDataSource) Configure(ctx context.Context,
 }
 
 func ParseOrganizationIdentityProviderID(id string) (string, string, error) {
-	parts := strings.SplitN(id, ":", 3)
-	if len(parts) != 4 || parts[0] == "" || parts[1] == "" || parts[2] == "" {
+	parts := strings.SplitN(id, ":", 2)
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
 		return "", "", fmt.Errorf("unexpected format of ID (%s), expected organizationId:identityProviderId", id)
 	}
 	return parts[0], parts[1], nil
@@ -67,16 +67,7 @@ func (d *OrganizationIdentityProviderDataSource) Read(ctx context.Context, req d
 		return
 	}
 
-	organizationId, identityProviderId, idErr := ParseOrganizationIdentityProviderID(data.OrganizationId.ValueString())
-	if idErr != nil {
-		resp.Diagnostics.AddError(
-			"Error parsing id",
-			data.OrganizationId.String(),
-		)
-		return
-	}
-
-	httpRes, err := d.client.OrganizationGetPlatformIdentityProvider(ctx, organizationId, identityProviderId)
+	httpRes, err := d.client.OrganizationGetPlatformIdentityProvider(ctx, data.OrganizationId.ValueString(), data.IdentityProviderId.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Unable to read item",
@@ -84,13 +75,11 @@ func (d *OrganizationIdentityProviderDataSource) Read(ctx context.Context, req d
 		)
 		return
 	}
+	defer httpRes.Body.Close()
 
 	var apiRes client.IdentityProvider
 	if httpRes.StatusCode != 200 {
-		resp.Diagnostics.AddError(
-			"Unexpected HTTP error code received",
-			httpRes.Status,
-		)
+		addAPIErrorDiagnostic(&resp.Diagnostics, httpRes)
 		return
 	}
 
@@ -1 +1,47 @@
 package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestParseOrganizationIdentityProviderID(t *testing.T) {
+	organizationId, identityProviderId, err := ParseOrganizationIdentityProviderID("DEFAULT:gravitee")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if organizationId != "DEFAULT" || identityProviderId != "gravitee" {
+		t.Errorf("unexpected parts: %s, %s", organizationId, identityProviderId)
+	}
+
+	for _, id := range []string{"", "DEFAULT", "DEFAULT:", ":gravitee"} {
+		if _, _, err := ParseOrganizationIdentityProviderID(id); err == nil {
+			t.Errorf("expected an error for %q", id)
+		}
+	}
+}
+
+func TestAccOrganizationIdentityProviderDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + testAccOrganizationIdentityProviderDataSourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.graviteeioam_organization_identity_provider.test", "id", "DEFAULT:gravitee"),
+					resource.TestCheckResourceAttr("data.graviteeioam_organization_identity_provider.test", "system", "true"),
+					resource.TestCheckResourceAttrSet("data.graviteeioam_organization_identity_provider.test", "type"),
+				),
+			},
+		},
+	})
+}
+
+const testAccOrganizationIdentityProviderDataSourceConfig = `
+data "graviteeioam_organization_identity_provider" "test" {
+  organization_id      = "DEFAULT"
+  identity_provider_id = "gravitee"
+}
+`
@@ -1 +1,372 @@
 package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sync"
+
+	"github.com/thornleyk/graviteeioam-service/client"
+	organizationIdentityModel "github.com/thornleyk/terraform-provider-graviteeioam/internal/model/organization_identity"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var _ resource.Resource = &OrganizationIdentityProviderResource{}
+var _ resource.ResourceWithImportState = &OrganizationIdentityProviderResource{}
+
+// organizationIdentitiesMutex serializes the read-modify-write updates of the
+// organization login page identities, shared by every organization identity
+// provider resource.
+var organizationIdentitiesMutex sync.Mutex
+
+func NewOrganizationIdentityProviderResource() resource.Resource {
+	return &OrganizationIdentityProviderResource{}
+}
+
+type OrganizationIdentityProviderResource struct {
+	client *client.Client
+}
+
+func (r *OrganizationIdentityProviderResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_organization_identity_provider"
+}
+
+func (r *OrganizationIdentityProviderResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = *organizationIdentityModel.GetOrganizationIdentityResourceSchema()
+}
+
+func (r *OrganizationIdentityProviderResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *OrganizationIdentityProviderResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data organizationIdentityModel.OrganizationIdentityResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	organizationId := data.OrganizationId.ValueString()
+
+	httpRes, err := r.client.OrganizationCreatePlatformIdentityProvider(ctx, organizationId, organizationIdentityModel.NewOrganizationIdentityFromResource(data))
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to create item",
+			err.Error(),
+		)
+		return
+	}
+	defer httpRes.Body.Close()
+
+	if httpRes.StatusCode != 201 {
+		addAPIErrorDiagnostic(&resp.Diagnostics, httpRes)
+		return
+	}
+
+	var apiRes client.IdentityProvider
+	if err := json.NewDecoder(httpRes.Body).Decode(&apiRes); err != nil {
+		resp.Diagnostics.AddError(
+			"Invalid format received",
+			err.Error(),
+		)
+		return
+	}
+
+	// The create endpoint does not accept the mappers, they are applied with
+	// a follow-up update.
+	if !data.UserMappers.IsNull() || !data.RoleMappers.IsNull() {
+		update, diags := organizationIdentityModel.UpdateOrganizationIdentityFromResource(ctx, data)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		updated, ok := r.updateIdentityProvider(ctx, organizationId, *apiRes.Id, update, &resp.Diagnostics)
+		if !ok {
+			// Keep the created identity provider in state so that it is not
+			// orphaned.
+			data = organizationIdentityModel.MapOrganizationIdentityResource(&apiRes, false, data)
+			resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+			return
+		}
+		apiRes = *updated
+	}
+
+	if data.LoginPage.ValueBool() && !r.setLoginPage(ctx, organizationId, *apiRes.Id, true, &resp.Diagnostics) {
+		data = organizationIdentityModel.MapOrganizationIdentityResource(&apiRes, false, data)
+		resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+		return
+	}
+
+	data = organizationIdentityModel.MapOrganizationIdentityResource(&apiRes, data.LoginPage.ValueBool(), data)
+
+	tflog.Trace(ctx, "created a resource")
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *OrganizationIdentityProviderResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data organizationIdentityModel.OrganizationIdentityResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	organizationId := data.OrganizationId.ValueString()
+
+	httpRes, err := r.client.OrganizationGetPlatformIdentityProvider(ctx, organizationId, data.IdentityProviderId.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to read item",
+			err.Error(),
+		)
+		return
+	}
+	defer httpRes.Body.Close()
+
+	if httpRes.StatusCode == 404 {
+		tflog.Warn(ctx, "Identity provider not found, removing from state", map[string]any{"id": data.Id.ValueString()})
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	if httpRes.StatusCode != 200 {
+		addAPIErrorDiagnostic(&resp.Diagnostics, httpRes)
+		return
+	}
+
+	var apiRes client.IdentityProvider
+	if err := json.NewDecoder(httpRes.Body).Decode(&apiRes); err != nil {
+		resp.Diagnostics.AddError(
+			"Invalid format received",
+			err.Error(),
+		)
+		return
+	}
+
+	identities, ok := r.readLoginPageIdentities(ctx, organizationId, &resp.Diagnostics)
+	if !ok {
+		return
+	}
+
+	data = organizationIdentityModel.MapOrganizationIdentityResource(&apiRes, containsString(identities, data.IdentityProviderId.ValueString()), data)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *OrganizationIdentityProviderResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data organizationIdentityModel.OrganizationIdentityResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	organizationId := data.OrganizationId.ValueString()
+	identityProviderId := data.IdentityProviderId.ValueString()
+
+	update, diags := organizationIdentityModel.UpdateOrganizationIdentityFromResource(ctx, data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	apiRes, ok := r.updateIdentityProvider(ctx, organizationId, identityProviderId, update, &resp.Diagnostics)
+	if !ok {
+		return
+	}
+
+	if !r.setLoginPage(ctx, organizationId, identityProviderId, data.LoginPage.ValueBool(), &resp.Diagnostics) {
+		return
+	}
+
+	data = organizationIdentityModel.MapOrganizationIdentityResource(apiRes, data.LoginPage.ValueBool(), data)
+
+	tflog.Trace(ctx, "updated a resource")
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *OrganizationIdentityProviderResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data organizationIdentityModel.OrganizationIdentityResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	organizationId := data.OrganizationId.ValueString()
+	identityProviderId := data.IdentityProviderId.ValueString()
+
+	// AM refuses to delete an identity provider still used by the
+	// organization.
+	if !r.setLoginPage(ctx, organizationId, identityProviderId, false, &resp.Diagnostics) {
+		return
+	}
+
+	httpRes, err := r.client.OrganizationDeletePlatformIdentityProvider(ctx, organizationId, identityProviderId)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to delete item",
+			err.Error(),
+		)
+		return
+	}
+	defer httpRes.Body.Close()
+
+	if httpRes.StatusCode != 204 && httpRes.StatusCode != 404 {
+		addAPIErrorDiagnostic(&resp.Diagnostics, httpRes)
+		return
+	}
+
+	tflog.Trace(ctx, "deleted a resource")
+}
+
+func (r *OrganizationIdentityProviderResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	organizationId, identityProviderId, idErr := ParseOrganizationIdentityProviderID(req.ID)
+	if idErr != nil {
+		resp.Diagnostics.AddError(
+			"Error parsing id",
+			idErr.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("organization_id"), organizationId)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("identity_provider_id"), identityProviderId)...)
+}
+
+func (r *OrganizationIdentityProviderResource) updateIdentityProvider(ctx context.Context, organizationId string, identityProviderId string, update client.UpdateIdentityProvider, diags *diag.Diagnostics) (*client.IdentityProvider, bool) {
+	httpRes, err := r.client.OrganizationUpdatePlatformIdentityProvider(ctx, organizationId, identityProviderId, update)
+	if err != nil {
+		diags.AddError(
+			"Unable to update item",
+			err.Error(),
+		)
+		return nil, false
+	}
+	defer httpRes.Body.Close()
+
+	if !isUpdateSuccess(httpRes) {
+		addAPIErrorDiagnostic(diags, httpRes)
+		return nil, false
+	}
+
+	var apiRes client.IdentityProvider
+	if err := json.NewDecoder(httpRes.Body).Decode(&apiRes); err != nil {
+		diags.AddError(
+			"Invalid format received",
+			err.Error(),
+		)
+		return nil, false
+	}
+	return &apiRes, true
+}
+
+// readLoginPageIdentities returns the identity providers shown on the
+// management console login page of an organization.
+func (r *OrganizationIdentityProviderResource) readLoginPageIdentities(ctx context.Context, organizationId string, diags *diag.Diagnostics) ([]string, bool) {
+	httpRes, err := r.client.OrganizationGetPlatformSettings(ctx, organizationId)
+	if err != nil {
+		diags.AddError(
+			"Unable to read item",
+			err.Error(),
+		)
+		return nil, false
+	}
+	defer httpRes.Body.Close()
+
+	if httpRes.StatusCode != 200 {
+		addAPIErrorDiagnostic(diags, httpRes)
+		return nil, false
+	}
+
+	var apiRes client.Organization
+	if err := json.NewDecoder(httpRes.Body).Decode(&apiRes); err != nil {
+		diags.AddError(
+			"Invalid format received",
+			err.Error(),
+		)
+		return nil, false
+	}
+	if apiRes.Identities == nil {
+		return []string{}, true
+	}
+	return *apiRes.Identities, true
+}
+
+// setLoginPage adds or removes an identity provider from the management
+// console login page of an organization.
+func (r *OrganizationIdentityProviderResource) setLoginPage(ctx context.Context, organizationId string, identityProviderId string, enabled bool, diags *diag.Diagnostics) bool {
+	organizationIdentitiesMutex.Lock()
+	defer organizationIdentitiesMutex.Unlock()
+
+	identities, ok := r.readLoginPageIdentities(ctx, organizationId, diags)
+	if !ok {
+		return false
+	}
+	if containsString(identities, identityProviderId) == enabled {
+		return true
+	}
+
+	updated := []string{}
+	for _, identity := range identities {
+		if identity != identityProviderId {
+			updated = append(updated, identity)
+		}
+	}
+	if enabled {
+		updated = append(updated, identityProviderId)
+	}
+
+	httpRes, err := r.client.OrganizationUpdatePlatformSettings(ctx, organizationId, client.PatchOrganization{"identities": updated})
+	if err != nil {
+		diags.AddError(
+			"Unable to update item",
+			err.Error(),
+		)
+		return false
+	}
+	defer httpRes.Body.Close()
+
+	if httpRes.StatusCode != 200 {
+		addAPIErrorDiagnostic(diags, httpRes)
+		return false
+	}
+	return true
+}
+
+func containsString(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
@@ -1 +1,58 @@
 package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccOrganizationIdentityProviderResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + testAccOrganizationIdentityProviderResourceConfig("tf-acc-org-idp", true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("graviteeioam_organization_identity_provider.test", "name", "tf-acc-org-idp"),
+					resource.TestCheckResourceAttr("graviteeioam_organization_identity_provider.test", "type", "inline-am-idp"),
+					resource.TestCheckResourceAttr("graviteeioam_organization_identity_provider.test", "login_page", "true"),
+					resource.TestCheckResourceAttr("graviteeioam_organization_identity_provider.test", "user_mappers.email", "mail"),
+					resource.TestCheckResourceAttrSet("graviteeioam_organization_identity_provider.test", "identity_provider_id"),
+				),
+			},
+			{
+				ResourceName:            "graviteeioam_organization_identity_provider.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"configuration"},
+			},
+			{
+				Config: providerConfig + testAccOrganizationIdentityProviderResourceConfig("tf-acc-org-idp-renamed", false),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("graviteeioam_organization_identity_provider.test", "name", "tf-acc-org-idp-renamed"),
+					resource.TestCheckResourceAttr("graviteeioam_organization_identity_provider.test", "login_page", "false"),
+				),
+			},
+		},
+	})
+}
+
+func testAccOrganizationIdentityProviderResourceConfig(name string, loginPage bool) string {
+	return fmt.Sprintf(`
+resource "graviteeioam_organization_identity_provider" "test" {
+  organization_id = "DEFAULT"
+  type            = "inline-am-idp"
+  name            = %[1]q
+  configuration = jsonencode({
+    passwordEncoder = "BCrypt"
+    users           = []
+  })
+  user_mappers = {
+    email = "mail"
+  }
+  login_page = %[2]t
+}
+`, name, loginPage)
+}
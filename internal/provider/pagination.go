@@ -0,0 +1,72 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// defaultPageSize is the number of items requested per page from the
+// paginated list endpoints of the management API.
+const defaultPageSize int32 = 50
+
+// pageFetcher requests a single page of a paginated list endpoint. Pages are
+// numbered from 0.
+type pageFetcher func(ctx context.Context, page int32, size int32) (*http.Response, error)
+
+// typedPage is the page envelope returned by the paginated list endpoints.
+type typedPage[T any] struct {
+	CurrentPage *int32 `json:"currentPage,omitempty"`
+	Data        []T    `json:"data,omitempty"`
+	TotalCount  *int64 `json:"totalCount,omitempty"`
+}
+
+// listAllPages walks every page of a paginated list endpoint and decodes the
+// items into a typed slice. It stops on an empty page or once totalCount
+// items were read, or on a short page when the total is not known as AM may
+// cap the page size below the requested one.
+func listAllPages[T any](ctx context.Context, fetch pageFetcher) ([]T, error) {
+	items := []T{}
+	for page := int32(0); ; page++ {
+		httpRes, err := fetch(ctx, page, defaultPageSize)
+		if err != nil {
+			return nil, err
+		}
+
+		result, err := decodePage[T](httpRes)
+		if err != nil {
+			return nil, err
+		}
+		items = append(items, result.Data...)
+
+		tflog.Trace(ctx, "read a page", map[string]any{"page": page, "items": len(result.Data), "total": len(items)})
+
+		if len(result.Data) == 0 {
+			return items, nil
+		}
+		if result.TotalCount == nil {
+			if len(result.Data) < int(defaultPageSize) {
+				return items, nil
+			}
+		} else if int64(len(items)) >= *result.TotalCount {
+			return items, nil
+		}
+	}
+}
+
+func decodePage[T any](httpRes *http.Response) (*typedPage[T], error) {
+	defer httpRes.Body.Close()
+
+	if httpRes.StatusCode != 200 {
+		return nil, NewAPIError(httpRes)
+	}
+
+	var result typedPage[T]
+	if err := json.NewDecoder(httpRes.Body).Decode(&result); err != nil {
+		return nil, fmt.Errorf("invalid page format received: %w", err)
+	}
+	return &result, nil
+}
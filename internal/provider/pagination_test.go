@@ -0,0 +1,100 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+)
+
+type testPageItem struct {
+	Id string `json:"id"`
+}
+
+// testPageServer serves total items, in pages of at most maxSize items when
+// it is set.
+func testPageServer(t *testing.T, total int, maxSize int, requested *[]int) *httptest.Server {
+	t.Helper()
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		page, _ := strconv.Atoi(r.URL.Query().Get("page"))
+		size, _ := strconv.Atoi(r.URL.Query().Get("size"))
+		if maxSize > 0 && size > maxSize {
+			size = maxSize
+		}
+		*requested = append(*requested, page)
+
+		data := []testPageItem{}
+		for i := page * size; i < total && i < (page+1)*size; i++ {
+			data = append(data, testPageItem{Id: strconv.Itoa(i)})
+		}
+		_ = json.NewEncoder(w).Encode(map[string]any{"currentPage": page, "data": data, "totalCount": total})
+	}))
+}
+
+func testPageFetcher(server *httptest.Server) pageFetcher {
+	return func(ctx context.Context, page int32, size int32) (*http.Response, error) {
+		req, _ := http.NewRequestWithContext(ctx, http.MethodGet, server.URL+"?page="+strconv.Itoa(int(page))+"&size="+strconv.Itoa(int(size)), nil)
+		return http.DefaultClient.Do(req)
+	}
+}
+
+func TestListAllPagesWalksEveryPage(t *testing.T) {
+	for total, pages := range map[int]int{0: 1, 1: 1, 50: 1, 51: 2, 120: 3} {
+		var requested []int
+		server := testPageServer(t, total, 0, &requested)
+
+		items, err := listAllPages[testPageItem](context.Background(), testPageFetcher(server))
+		server.Close()
+		if err != nil {
+			t.Fatalf("%d: unexpected error: %s", total, err)
+		}
+		if len(items) != total {
+			t.Errorf("%d: expected %d items, got %d", total, total, len(items))
+		}
+		if len(requested) != pages {
+			t.Errorf("%d: expected %d page requests, got %v", total, pages, requested)
+		}
+		for i, item := range items {
+			if item.Id != strconv.Itoa(i) {
+				t.Errorf("%d: expected item %d to be decoded in order, got %q", total, i, item.Id)
+				break
+			}
+		}
+	}
+}
+
+func TestListAllPagesWalksCappedPages(t *testing.T) {
+	var requested []int
+	server := testPageServer(t, 45, 20, &requested)
+	defer server.Close()
+
+	items, err := listAllPages[testPageItem](context.Background(), testPageFetcher(server))
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if len(items) != 45 {
+		t.Errorf("expected 45 items, got %d", len(items))
+	}
+	if len(requested) != 3 {
+		t.Errorf("expected 3 page requests, got %v", requested)
+	}
+}
+
+func TestListAllPagesReturnsAPIError(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusForbidden)
+		_, _ = w.Write([]byte(`{"message":"Permission [DOMAIN[LIST]] required","http_status":403}`))
+	}))
+	defer server.Close()
+
+	_, err := listAllPages[testPageItem](context.Background(), testPageFetcher(server))
+	apiErr, ok := err.(*APIError)
+	if !ok {
+		t.Fatalf("expected an *APIError, got %T: %v", err, err)
+	}
+	if apiErr.StatusCode != http.StatusForbidden || apiErr.Message != "Permission [DOMAIN[LIST]] required" {
+		t.Errorf("unexpected error: %+v", apiErr)
+	}
+}
@@ -3,12 +3,19 @@ package provider
 import (
 	"context"
 	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
 	"os"
+	"strconv"
+	"strings"
+	"time"
 
 	"github.com/thornleyk/graviteeioam-service/client"
 
 	"github.com/deepmap/oapi-codegen/pkg/securityprovider"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
@@ -28,11 +35,32 @@ type GraviteeIOAMProvider struct {
 	version string
 }
 
+const (
+	providerAuthModeBasic             = "basic"
+	providerAuthModeAccessToken       = "access_token"
+	providerAuthModeClientCredentials = "client_credentials"
+)
+
 // ScaffoldingProviderModel describes the provider data model.
 type GraviteeIOAMProviderModel struct {
-	Endpoint types.String `tfsdk:"endpoint"`
-	Username types.String `tfsdk:"username"`
-	Password types.String `tfsdk:"password"`
+	Endpoint      types.String `tfsdk:"endpoint"`
+	Username      types.String `tfsdk:"username"`
+	Password      types.String `tfsdk:"password"`
+	AccessToken   types.String `tfsdk:"access_token"`
+	ClientId      types.String `tfsdk:"client_id"`
+	ClientSecret  types.String `tfsdk:"client_secret"`
+	TokenEndpoint types.String `tfsdk:"token_endpoint"`
+
+	CACertPEM          types.String `tfsdk:"ca_cert_pem"`
+	CACertFile         types.String `tfsdk:"ca_cert_file"`
+	ClientCertPEM      types.String `tfsdk:"client_cert_pem"`
+	ClientKeyPEM       types.String `tfsdk:"client_key_pem"`
+	InsecureSkipVerify types.Bool   `tfsdk:"insecure_skip_verify"`
+
+	MaxRetries         types.Int64 `tfsdk:"max_retries"`
+	RetryMinWait       types.Int64 `tfsdk:"retry_min_wait"`
+	RetryMaxWait       types.Int64 `tfsdk:"retry_max_wait"`
+	RetryNonIdempotent types.Bool  `tfsdk:"retry_non_idempotent"`
 }
 
 func (p *GraviteeIOAMProvider) Metadata(ctx context.Context, req provider.MetadataRequest, resp *provider.MetadataResponse) {
@@ -56,6 +84,61 @@ func (p *GraviteeIOAMProvider) Schema(ctx context.Context, req provider.SchemaRe
 				Optional:            true,
 				Sensitive:           true,
 			},
+			"access_token": schema.StringAttribute{
+				MarkdownDescription: "GraviteeIO AM pre-issued access token, such as a personal access token. Conflicts with `username`/`password` and `client_id`/`client_secret`",
+				Optional:            true,
+				Sensitive:           true,
+			},
+			"client_id": schema.StringAttribute{
+				MarkdownDescription: "Client id of a service account used with the client credentials grant",
+				Optional:            true,
+			},
+			"client_secret": schema.StringAttribute{
+				MarkdownDescription: "Client secret of a service account used with the client credentials grant",
+				Optional:            true,
+				Sensitive:           true,
+			},
+			"token_endpoint": schema.StringAttribute{
+				MarkdownDescription: "OAuth2 token endpoint used with the client credentials grant",
+				Optional:            true,
+			},
+			"ca_cert_pem": schema.StringAttribute{
+				MarkdownDescription: "PEM encoded CA certificates trusted for the GraviteeIO AM endpoint, in addition to the system pool",
+				Optional:            true,
+			},
+			"ca_cert_file": schema.StringAttribute{
+				MarkdownDescription: "Path to a PEM file of CA certificates trusted for the GraviteeIO AM endpoint, in addition to the system pool",
+				Optional:            true,
+			},
+			"client_cert_pem": schema.StringAttribute{
+				MarkdownDescription: "PEM encoded client certificate for mutual TLS",
+				Optional:            true,
+			},
+			"client_key_pem": schema.StringAttribute{
+				MarkdownDescription: "PEM encoded private key of the client certificate for mutual TLS",
+				Optional:            true,
+				Sensitive:           true,
+			},
+			"insecure_skip_verify": schema.BoolAttribute{
+				MarkdownDescription: "Skip verification of the GraviteeIO AM endpoint certificate. Only use this for testing",
+				Optional:            true,
+			},
+			"max_retries": schema.Int64Attribute{
+				MarkdownDescription: "Maximum number of retries of a request failing with a transient error (429, 502, 503, 504 or a connection error). Defaults to 3",
+				Optional:            true,
+			},
+			"retry_min_wait": schema.Int64Attribute{
+				MarkdownDescription: "Minimum time in seconds to wait between retries, doubled on every attempt. Defaults to 1",
+				Optional:            true,
+			},
+			"retry_max_wait": schema.Int64Attribute{
+				MarkdownDescription: "Maximum time in seconds to wait between retries, including waits requested through Retry-After. Defaults to 30",
+				Optional:            true,
+			},
+			"retry_non_idempotent": schema.BoolAttribute{
+				MarkdownDescription: "Also retry POST and PATCH requests. Defaults to false, as these may have been applied before the failure",
+				Optional:            true,
+			},
 		},
 	}
 }
@@ -88,6 +171,15 @@ func (p *GraviteeIOAMProvider) Configure(ctx context.Context, req provider.Confi
 		)
 	}
 
+	if config.InsecureSkipVerify.IsUnknown() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("insecure_skip_verify"),
+			"Unknown GraviteeIOAM insecure_skip_verify",
+			"The provider cannot create the GraviteeIOAM API client as there is an unknown configuration value for the GraviteeIOAM insecure_skip_verify. "+
+				"Either target apply the source of the value first, set the value statically in the configuration, or use the GRAVITEEIOAM_INSECURE_SKIP_VERIFY environment variable.",
+		)
+	}
+
 	if config.Endpoint.IsUnknown() {
 		resp.Diagnostics.AddAttributeError(
 			path.Root("endpoint"),
@@ -97,9 +189,40 @@ func (p *GraviteeIOAMProvider) Configure(ctx context.Context, req provider.Confi
 		)
 	}
 
+	for attribute, value := range map[string]types.String{
+		"access_token":    config.AccessToken,
+		"client_id":       config.ClientId,
+		"client_secret":   config.ClientSecret,
+		"token_endpoint":  config.TokenEndpoint,
+		"ca_cert_pem":     config.CACertPEM,
+		"ca_cert_file":    config.CACertFile,
+		"client_cert_pem": config.ClientCertPEM,
+		"client_key_pem":  config.ClientKeyPEM,
+	} {
+		if value.IsUnknown() {
+			resp.Diagnostics.AddAttributeError(
+				path.Root(attribute),
+				"Unknown GraviteeIOAM "+attribute,
+				"The provider cannot create the GraviteeIOAM API client as there is an unknown configuration value for the GraviteeIOAM "+attribute+". "+
+					"Either target apply the source of the value first, set the value statically in the configuration, or use the GRAVITEEIOAM_"+strings.ToUpper(attribute)+" environment variable.",
+			)
+		}
+	}
+
 	username := os.Getenv("GRAVITEEIOAM_USERNAME")
 	password := os.Getenv("GRAVITEEIOAM_PASSWORD")
 	endpoint := os.Getenv("GRAVITEEIOAM_ENDPOINT")
+	accessToken := os.Getenv("GRAVITEEIOAM_ACCESS_TOKEN")
+	clientId := os.Getenv("GRAVITEEIOAM_CLIENT_ID")
+	clientSecret := os.Getenv("GRAVITEEIOAM_CLIENT_SECRET")
+	tokenEndpoint := os.Getenv("GRAVITEEIOAM_TOKEN_ENDPOINT")
+	tlsSettings := providerTLSConfig{
+		CACertPEM:     os.Getenv("GRAVITEEIOAM_CA_CERT_PEM"),
+		CACertFile:    os.Getenv("GRAVITEEIOAM_CA_CERT_FILE"),
+		ClientCertPEM: os.Getenv("GRAVITEEIOAM_CLIENT_CERT_PEM"),
+		ClientKeyPEM:  os.Getenv("GRAVITEEIOAM_CLIENT_KEY_PEM"),
+	}
+	tlsSettings.InsecureSkipVerify, _ = strconv.ParseBool(os.Getenv("GRAVITEEIOAM_INSECURE_SKIP_VERIFY"))
 
 	if !config.Username.IsNull() {
 		username = config.Username.ValueString()
@@ -113,26 +236,98 @@ func (p *GraviteeIOAMProvider) Configure(ctx context.Context, req provider.Confi
 		endpoint = config.Endpoint.ValueString()
 	}
 
-	if username == "" {
-		resp.Diagnostics.AddAttributeWarning(
-			path.Root("username"),
-			"Missing GraviteeIOAM API username (using default value: admin)",
-			"The provider is using a default value as there is a missing or empty value for the Inventory API host. "+
-				"Set the host value in the configuration or use the GRAVITEEIOAM_USERNAME environment variable. "+
-				"If either is already set, ensure the value is not empty.",
+	if !config.AccessToken.IsNull() {
+		accessToken = config.AccessToken.ValueString()
+	}
+
+	if !config.ClientId.IsNull() {
+		clientId = config.ClientId.ValueString()
+	}
+
+	if !config.ClientSecret.IsNull() {
+		clientSecret = config.ClientSecret.ValueString()
+	}
+
+	if !config.TokenEndpoint.IsNull() {
+		tokenEndpoint = config.TokenEndpoint.ValueString()
+	}
+
+	if !config.CACertPEM.IsNull() {
+		tlsSettings.CACertPEM = config.CACertPEM.ValueString()
+	}
+
+	if !config.CACertFile.IsNull() {
+		tlsSettings.CACertFile = config.CACertFile.ValueString()
+	}
+
+	if !config.ClientCertPEM.IsNull() {
+		tlsSettings.ClientCertPEM = config.ClientCertPEM.ValueString()
+	}
+
+	if !config.ClientKeyPEM.IsNull() {
+		tlsSettings.ClientKeyPEM = config.ClientKeyPEM.ValueString()
+	}
+
+	if !config.InsecureSkipVerify.IsNull() {
+		tlsSettings.InsecureSkipVerify = config.InsecureSkipVerify.ValueBool()
+	}
+
+	retrySettings := providerRetryConfig{
+		MaxRetries: defaultMaxRetries,
+		MinWait:    defaultRetryMinWait,
+		MaxWait:    defaultRetryMaxWait,
+	}
+
+	if !config.MaxRetries.IsNull() && !config.MaxRetries.IsUnknown() {
+		retrySettings.MaxRetries = int(config.MaxRetries.ValueInt64())
+	}
+
+	if !config.RetryMinWait.IsNull() && !config.RetryMinWait.IsUnknown() {
+		retrySettings.MinWait = time.Duration(config.RetryMinWait.ValueInt64()) * time.Second
+	}
+
+	if !config.RetryMaxWait.IsNull() && !config.RetryMaxWait.IsUnknown() {
+		retrySettings.MaxWait = time.Duration(config.RetryMaxWait.ValueInt64()) * time.Second
+	}
+
+	if !config.RetryNonIdempotent.IsNull() && !config.RetryNonIdempotent.IsUnknown() {
+		retrySettings.RetryNonIdempotent = config.RetryNonIdempotent.ValueBool()
+	}
+
+	if retrySettings.MaxRetries < 0 {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("max_retries"),
+			"Invalid GraviteeIOAM max_retries",
+			"max_retries must not be negative.",
 		)
-		username = "admin"
 	}
 
-	if password == "" {
-		resp.Diagnostics.AddAttributeWarning(
-			path.Root("password"),
-			"Missing GraviteeIOAM API password (using default value: admin)",
-			"The provider is using a default value as there is a missing or empty value for the GraviteeIOAM API host. "+
-				"Set the host value in the configuration or use the GRAVITEEIOAM_USERNAME environment variable. "+
-				"If either is already set, ensure the value is not empty.",
+	if retrySettings.MinWait < 0 {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("retry_min_wait"),
+			"Invalid GraviteeIOAM retry_min_wait",
+			"retry_min_wait must not be negative.",
 		)
-		username = "admin"
+	} else if retrySettings.MaxWait < retrySettings.MinWait {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("retry_max_wait"),
+			"Invalid GraviteeIOAM retry_max_wait",
+			"retry_max_wait must not be lower than retry_min_wait.",
+		)
+	}
+
+	auth := providerAuthConfig{
+		Username:      username,
+		Password:      password,
+		AccessToken:   accessToken,
+		ClientId:      clientId,
+		ClientSecret:  clientSecret,
+		TokenEndpoint: tokenEndpoint,
+	}
+	authMode, authDiags := resolveAuthMode(&auth)
+	resp.Diagnostics.Append(authDiags...)
+	if resp.Diagnostics.HasError() {
+		return
 	}
 
 	if endpoint == "" {
@@ -150,80 +345,258 @@ func (p *GraviteeIOAMProvider) Configure(ctx context.Context, req provider.Confi
 		return
 	}
 
-	basicAuthProvider, basicAuthProviderErr := securityprovider.NewSecurityProviderBasicAuth(username, password)
-	if basicAuthProviderErr != nil {
-		panic(basicAuthProviderErr)
-	}
-	authApi, authApiErr := client.NewClient(endpoint, client.WithRequestEditorFn(basicAuthProvider.Intercept))
-
-	if authApiErr != nil {
+	tlsConfig, tlsErr := newTLSConfig(tlsSettings)
+	if tlsErr != nil {
 		resp.Diagnostics.AddError(
-			"Unable to Create GraviteeIOAM API Client",
-			"An unexpected error occurred when creating the GraviteeIOAM API client. "+
-				"If the error is not clear, please contact the provider developers.\n\n"+
-				"GraviteeIOAM Client Error: "+authApiErr.Error(),
+			"Invalid GraviteeIOAM TLS configuration",
+			tlsErr.Error(),
 		)
 		return
 	}
 
-	authToken, authTokenErr := authApi.AuthTokenExchange(ctx)
+	if tlsSettings.InsecureSkipVerify {
+		resp.Diagnostics.AddAttributeWarning(
+			path.Root("insecure_skip_verify"),
+			"GraviteeIOAM endpoint certificate verification is disabled",
+			"The provider does not verify the certificate of the GraviteeIO AM endpoint. Only use insecure_skip_verify for testing.",
+		)
+	}
+
+	httpClient := newHTTPClient(tlsConfig, retrySettings)
+
+	clientOptions := []client.ClientOption{client.WithHTTPClient(httpClient)}
+	switch authMode {
+	case providerAuthModeAccessToken:
+		bearerTokenProvider, bearerTokenProviderErr := securityprovider.NewSecurityProviderBearerToken(auth.AccessToken)
+		if bearerTokenProviderErr != nil {
+			panic(bearerTokenProviderErr)
+		}
+		clientOptions = append(clientOptions, client.WithRequestEditorFn(bearerTokenProvider.Intercept))
+	case providerAuthModeClientCredentials:
+		tokens := newTokenSource(newClientCredentialsAuthenticator(httpClient, auth.TokenEndpoint, auth.ClientId, auth.ClientSecret), httpClient)
+		if !p.authenticate(ctx, tokens, resp) {
+			return
+		}
+		clientOptions = append(clientOptions, client.WithHTTPClient(tokens), client.WithRequestEditorFn(tokens.Intercept))
+	default:
+		basicAuthProvider, basicAuthProviderErr := securityprovider.NewSecurityProviderBasicAuth(auth.Username, auth.Password)
+		if basicAuthProviderErr != nil {
+			panic(basicAuthProviderErr)
+		}
+		authApi, authApiErr := client.NewClient(endpoint, client.WithHTTPClient(httpClient), client.WithRequestEditorFn(basicAuthProvider.Intercept))
+
+		if authApiErr != nil {
+			resp.Diagnostics.AddError(
+				"Unable to Create GraviteeIOAM API Client",
+				"An unexpected error occurred when creating the GraviteeIOAM API client. "+
+					"If the error is not clear, please contact the provider developers.\n\n"+
+					"GraviteeIOAM Client Error: "+authApiErr.Error(),
+			)
+			return
+		}
+
+		tokens := newTokenSource(newTokenExchangeAuthenticator(authApi), httpClient)
+		if !p.authenticate(ctx, tokens, resp) {
+			return
+		}
+		clientOptions = append(clientOptions, client.WithHTTPClient(tokens), client.WithRequestEditorFn(tokens.Intercept))
+	}
 
-	//authResp, _ := io.ReadAll(authToken.Body)
+	api, apiErr := client.NewClient(endpoint, clientOptions...)
 
-	if authTokenErr != nil {
+	if apiErr != nil {
 		resp.Diagnostics.AddError(
 			"Unable to Create GraviteeIOAM API Client",
-			"An unexpected error occurred when authenticating the GraviteeIOAM API client. "+
+			"An unexpected error occurred when creating the GraviteeIOAM API client. "+
 				"If the error is not clear, please contact the provider developers.\n\n"+
-				"GraviteeIOAM Client Error: "+authTokenErr.Error(),
+				"GraviteeIOAM Client Error: "+apiErr.Error(),
 		)
 		return
 	}
 
-	if authToken.StatusCode != 200 {
-		resp.Diagnostics.AddError(
-			"Unexpected HTTP error code received for Authentication",
-			authToken.Status,
+	resp.DataSourceData = api
+	resp.ResourceData = api
+	tflog.Info(ctx, "Configured GraviteeIOAM client", map[string]any{"success": true})
+
+}
+
+// providerAuthConfig holds the credentials of the provider, from the provider
+// configuration or the GRAVITEEIOAM_* environment variables.
+type providerAuthConfig struct {
+	Username      string
+	Password      string
+	AccessToken   string
+	ClientId      string
+	ClientSecret  string
+	TokenEndpoint string
+}
+
+// resolveAuthMode returns the authentication mode of the credentials.
+// Conflicting modes and incomplete client credentials are reported as errors,
+// missing basic credentials default to admin/admin with a warning.
+func resolveAuthMode(auth *providerAuthConfig) (string, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	authMode := providerAuthModeBasic
+	var authModes []string
+	if auth.Username != "" || auth.Password != "" {
+		authModes = append(authModes, "username/password")
+	}
+	if auth.AccessToken != "" {
+		authMode = providerAuthModeAccessToken
+		authModes = append(authModes, "access_token")
+	}
+	if auth.ClientId != "" || auth.ClientSecret != "" || auth.TokenEndpoint != "" {
+		authMode = providerAuthModeClientCredentials
+		authModes = append(authModes, "client_id/client_secret")
+	}
+
+	if len(authModes) > 1 {
+		diags.AddError(
+			"Conflicting GraviteeIOAM authentication configuration",
+			"Exactly one authentication mode must be configured, either username/password, access_token or client_id/client_secret/token_endpoint, "+
+				"through the provider configuration or the GRAVITEEIOAM_* environment variables. Found: "+strings.Join(authModes, ", ")+".",
 		)
-		return
+		return authMode, diags
 	}
 
-	//tflog.Info(ctx, "Authenticated GraviteeIOAM client "+string(authResp))
+	if authMode == providerAuthModeClientCredentials {
+		for attribute, value := range map[string]string{"client_id": auth.ClientId, "client_secret": auth.ClientSecret, "token_endpoint": auth.TokenEndpoint} {
+			if value == "" {
+				diags.AddAttributeError(
+					path.Root(attribute),
+					"Missing GraviteeIOAM "+attribute,
+					"The client credentials authentication mode requires client_id, client_secret and token_endpoint. "+
+						"Set the "+attribute+" value in the configuration or use the GRAVITEEIOAM_"+strings.ToUpper(attribute)+" environment variable.",
+				)
+			}
+		}
+	}
 
-	var token client.AuthToken
-	if err := json.NewDecoder(authToken.Body).Decode(&token); err != nil {
-		resp.Diagnostics.AddError(
-			"Invalid format received for AuthToken",
-			err.Error(),
+	if authMode == providerAuthModeBasic && auth.Username == "" {
+		diags.AddAttributeWarning(
+			path.Root("username"),
+			"Missing GraviteeIOAM API username (using default value: admin)",
+			"The provider is using a default value as there is a missing or empty value for the Inventory API host. "+
+				"Set the host value in the configuration or use the GRAVITEEIOAM_USERNAME environment variable. "+
+				"If either is already set, ensure the value is not empty.",
 		)
-		return
+		auth.Username = "admin"
 	}
 
-	bearerTokenProvider, bearerTokenProviderErr := securityprovider.NewSecurityProviderBearerToken(*token.AccessToken)
-	if bearerTokenProviderErr != nil {
-		panic(bearerTokenProviderErr)
+	if authMode == providerAuthModeBasic && auth.Password == "" {
+		diags.AddAttributeWarning(
+			path.Root("password"),
+			"Missing GraviteeIOAM API password (using default value: admin)",
+			"The provider is using a default value as there is a missing or empty value for the GraviteeIOAM API host. "+
+				"Set the host value in the configuration or use the GRAVITEEIOAM_PASSWORD environment variable. "+
+				"If either is already set, ensure the value is not empty.",
+		)
+		auth.Password = "admin"
 	}
-	api, apiErr := client.NewClient(endpoint, client.WithRequestEditorFn(bearerTokenProvider.Intercept))
 
-	if apiErr != nil {
+	return authMode, diags
+}
+
+// authenticate fetches the first access token so that invalid credentials are
+// reported while configuring the provider rather than on the first request.
+func (p *GraviteeIOAMProvider) authenticate(ctx context.Context, tokens *tokenSource, resp *provider.ConfigureResponse) bool {
+	if _, tokenErr := tokens.Token(ctx); tokenErr != nil {
 		resp.Diagnostics.AddError(
 			"Unable to Create GraviteeIOAM API Client",
-			"An unexpected error occurred when creating the GraviteeIOAM API client. "+
+			"An unexpected error occurred when authenticating the GraviteeIOAM API client. "+
 				"If the error is not clear, please contact the provider developers.\n\n"+
-				"GraviteeIOAM Client Error: "+apiErr.Error(),
+				"GraviteeIOAM Client Error: "+tokenErr.Error(),
 		)
-		return
+		return false
 	}
+	return true
+}
 
-	resp.DataSourceData = api
-	resp.ResourceData = api
-	tflog.Info(ctx, "Configured GraviteeIOAM client", map[string]any{"success": true})
+// newTokenExchangeAuthenticator exchanges the basic auth credentials of the
+// given client for a management API access token.
+func newTokenExchangeAuthenticator(authApi *client.Client) tokenAuthenticator {
+	return func(ctx context.Context) (*client.AuthToken, error) {
+		authToken, err := authApi.AuthTokenExchange(ctx)
+		if err != nil {
+			return nil, err
+		}
+		defer authToken.Body.Close()
+
+		if authToken.StatusCode != 200 {
+			return nil, NewAPIError(authToken)
+		}
+
+		var token client.AuthToken
+		if err := json.NewDecoder(authToken.Body).Decode(&token); err != nil {
+			return nil, fmt.Errorf("invalid format received for AuthToken: %w", err)
+		}
+		return &token, nil
+	}
+}
+
+// newClientCredentialsAuthenticator obtains a management API access token for
+// a service account with the OAuth2 client credentials grant.
+func newClientCredentialsAuthenticator(doer client.HttpRequestDoer, tokenEndpoint string, clientId string, clientSecret string) tokenAuthenticator {
+	return func(ctx context.Context) (*client.AuthToken, error) {
+		form := url.Values{}
+		form.Set("grant_type", "client_credentials")
+
+		tokenReq, err := http.NewRequestWithContext(ctx, http.MethodPost, tokenEndpoint, strings.NewReader(form.Encode()))
+		if err != nil {
+			return nil, err
+		}
+		tokenReq.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+		tokenReq.Header.Set("Accept", "application/json")
+		tokenReq.SetBasicAuth(url.QueryEscape(clientId), url.QueryEscape(clientSecret))
 
+		tokenRes, err := doer.Do(tokenReq)
+		if err != nil {
+			return nil, err
+		}
+		defer tokenRes.Body.Close()
+
+		if tokenRes.StatusCode != 200 {
+			return nil, NewAPIError(tokenRes)
+		}
+
+		var token struct {
+			AccessToken *string `json:"access_token"`
+			TokenType   *string `json:"token_type"`
+			ExpiresIn   *int64  `json:"expires_in"`
+		}
+		if err := json.NewDecoder(tokenRes.Body).Decode(&token); err != nil {
+			return nil, fmt.Errorf("invalid format received for client credentials token: %w", err)
+		}
+
+		authToken := client.AuthToken{
+			AccessToken: token.AccessToken,
+			TokenType:   token.TokenType,
+		}
+		if token.ExpiresIn != nil {
+			expiresAt := strconv.FormatInt(time.Now().Add(time.Duration(*token.ExpiresIn)*time.Second).Unix(), 10)
+			authToken.ExpiresAt = &expiresAt
+		}
+		return &authToken, nil
+	}
 }
 
 func (p *GraviteeIOAMProvider) Resources(ctx context.Context) []func() resource.Resource {
 	return []func() resource.Resource{
 		NewDomainResource,
+		NewApplicationResource,
+		NewDomainIdentityProviderResource,
+		NewOrganizationIdentityProviderResource,
+		NewCertificateResource,
+		NewScopeResource,
+		NewRoleResource,
+		NewGroupResource,
+		NewGroupMembershipResource,
+		NewUserResource,
+		NewFactorResource,
+		NewServiceResourceResource,
+		NewReporterResource,
+		NewFlowResource,
+		NewFormResource,
 	}
 }
 
@@ -232,6 +605,10 @@ func (p *GraviteeIOAMProvider) DataSources(ctx context.Context) []func() datasou
 		NewDomainDataSource,
 		NewOrganizationDataSource,
 		NewEnvironmentDataSource,
+		NewDomainIdentityProviderDataSource,
+		NewOrganizationIdentityProviderDataSource,
+		NewCertificatesDataSource,
+		NewScopesDataSource,
 	}
 }
 
@@ -1,7 +1,11 @@
 package provider
 
 import (
+	"context"
+	"net/http"
+	"net/http/httptest"
 	"testing"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-framework/providerserver"
 	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
@@ -39,3 +43,69 @@ func testAccPreCheck(t *testing.T) {
 	// about the appropriate environment variables being set are common to see in a pre-check
 	// function.
 }
+
+func TestResolveAuthMode(t *testing.T) {
+	for name, test := range map[string]struct {
+		auth     providerAuthConfig
+		mode     string
+		errors   int
+		warnings int
+	}{
+		"basic":                 {auth: providerAuthConfig{Username: "admin", Password: "secret"}, mode: providerAuthModeBasic},
+		"basic defaults":        {auth: providerAuthConfig{}, mode: providerAuthModeBasic, warnings: 2},
+		"access token":          {auth: providerAuthConfig{AccessToken: "token"}, mode: providerAuthModeAccessToken},
+		"client credentials":    {auth: providerAuthConfig{ClientId: "id", ClientSecret: "secret", TokenEndpoint: "https://am/token"}, mode: providerAuthModeClientCredentials},
+		"missing client secret": {auth: providerAuthConfig{ClientId: "id", TokenEndpoint: "https://am/token"}, mode: providerAuthModeClientCredentials, errors: 1},
+		"only token endpoint":   {auth: providerAuthConfig{TokenEndpoint: "https://am/token"}, mode: providerAuthModeClientCredentials, errors: 2},
+		"token and password":    {auth: providerAuthConfig{Username: "admin", AccessToken: "token"}, errors: 1},
+		"token and client":      {auth: providerAuthConfig{AccessToken: "token", ClientId: "id"}, errors: 1},
+	} {
+		auth := test.auth
+		mode, diags := resolveAuthMode(&auth)
+		if diags.ErrorsCount() != test.errors || diags.WarningsCount() != test.warnings {
+			t.Errorf("%s: expected %d errors and %d warnings, got %v", name, test.errors, test.warnings, diags)
+		}
+		if test.errors == 0 && mode != test.mode {
+			t.Errorf("%s: expected the %s mode, got %s", name, test.mode, mode)
+		}
+	}
+
+	auth := providerAuthConfig{}
+	if _, diags := resolveAuthMode(&auth); diags.HasError() || auth.Username != "admin" || auth.Password != "admin" {
+		t.Errorf("expected missing basic credentials to default to admin/admin, got %+v", auth)
+	}
+}
+
+func TestClientCredentialsAuthenticator(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		clientId, clientSecret, ok := r.BasicAuth()
+		if err := r.ParseForm(); err != nil || r.Form.Get("grant_type") != "client_credentials" {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		if !ok || clientId != "id" || clientSecret != "secret" {
+			w.WriteHeader(http.StatusUnauthorized)
+			_, _ = w.Write([]byte(`{"message": "Invalid client", "http_status": 401}`))
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"access_token": "token", "token_type": "bearer", "expires_in": 3600}`))
+	}))
+	defer server.Close()
+
+	before := time.Now()
+	token, err := newClientCredentialsAuthenticator(http.DefaultClient, server.URL, "id", "secret")(context.Background())
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if *token.AccessToken != "token" || *token.TokenType != "bearer" {
+		t.Errorf("unexpected token: %+v", token)
+	}
+	if expiry := tokenExpiry(token); expiry.Before(before.Add(59*time.Minute)) || expiry.After(time.Now().Add(time.Hour)) {
+		t.Errorf("expected the token to expire in an hour, got %s", expiry)
+	}
+
+	if _, err := newClientCredentialsAuthenticator(http.DefaultClient, server.URL, "id", "wrong")(context.Background()); err == nil {
+		t.Error("expected invalid client credentials to be reported")
+	}
+}
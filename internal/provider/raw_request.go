@@ -0,0 +1,50 @@
+package provider
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/url"
+
+	"github.com/thornleyk/graviteeioam-service/client"
+)
+
+// doRequest sends a request to a management API endpoint that the client
+// does not expose. The path is relative to the management endpoint, the
+// body is JSON encoded when set and the request editors of the client, which
+// authenticate the request, are applied.
+func doRequest(ctx context.Context, c *client.Client, method string, path string, body any) (*http.Response, error) {
+	serverURL, err := url.Parse(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	queryURL, err := serverURL.Parse("." + path)
+	if err != nil {
+		return nil, err
+	}
+
+	var reader io.Reader
+	if body != nil {
+		encoded, err := json.Marshal(body)
+		if err != nil {
+			return nil, err
+		}
+		reader = bytes.NewReader(encoded)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, queryURL.String(), reader)
+	if err != nil {
+		return nil, err
+	}
+	if body != nil {
+		req.Header.Add("Content-Type", "application/json")
+	}
+	for _, editor := range c.RequestEditors {
+		if err := editor(ctx, req); err != nil {
+			return nil, err
+		}
+	}
+	return c.Client.Do(req)
+}
@@ -0,0 +1,45 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/thornleyk/graviteeioam-service/client"
+)
+
+func TestDoRequest(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost || r.URL.Path != "/management/organizations/DEFAULT/reporters" {
+			t.Errorf("unexpected request: %s %s", r.Method, r.URL.Path)
+		}
+		if r.Header.Get("Authorization") != "Bearer token" || r.Header.Get("Content-Type") != "application/json" {
+			t.Errorf("unexpected headers: %v", r.Header)
+		}
+		var body map[string]string
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil || body["name"] != "audit" {
+			t.Errorf("unexpected body: %v, %v", body, err)
+		}
+		w.WriteHeader(http.StatusCreated)
+	}))
+	defer server.Close()
+
+	api, err := client.NewClient(server.URL+"/management", client.WithRequestEditorFn(func(ctx context.Context, req *http.Request) error {
+		req.Header.Set("Authorization", "Bearer token")
+		return nil
+	}))
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	httpRes, err := doRequest(context.Background(), api, http.MethodPost, "/organizations/DEFAULT/reporters", map[string]string{"name": "audit"})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	defer httpRes.Body.Close()
+	if httpRes.StatusCode != http.StatusCreated {
+		t.Errorf("unexpected status: %d", httpRes.StatusCode)
+	}
+}
@@ -0,0 +1,372 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+
+	"github.com/thornleyk/graviteeioam-service/client"
+	"github.com/thornleyk/terraform-provider-graviteeioam/internal/model/convert"
+	reporterModel "github.com/thornleyk/terraform-provider-graviteeioam/internal/model/reporter"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var _ resource.Resource = &ReporterResource{}
+var _ resource.ResourceWithImportState = &ReporterResource{}
+var _ resource.ResourceWithValidateConfig = &ReporterResource{}
+
+func NewReporterResource() resource.Resource {
+	return &ReporterResource{}
+}
+
+type ReporterResource struct {
+	client *client.Client
+}
+
+// ParseReporterID parses the id of a domain reporter, organizationId:environmentId:domainId:reporterId,
+// or of an organization reporter, organizationId:reporterId, and returns its reference type.
+func ParseReporterID(id string) (string, string, string, string, string, error) {
+	return parseReferenceID(id, "reporterId")
+}
+
+// organizationReporterPath returns the path of the reporters of an
+// organization, or of one of them when reporterId is set. The management API
+// client only exposes the reporters of a domain.
+func organizationReporterPath(organizationId string, reporterId string) string {
+	reporterPath := "/organizations/" + url.PathEscape(organizationId) + "/reporters"
+	if reporterId != "" {
+		reporterPath += "/" + url.PathEscape(reporterId)
+	}
+	return reporterPath
+}
+
+func (r *ReporterResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_reporter"
+}
+
+func (r *ReporterResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = *reporterModel.GetReporterResourceSchema()
+}
+
+func (r *ReporterResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *ReporterResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data reporterModel.ReporterResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	validateReference("reporters", data.ReferenceType, data.EnvironmentId, data.DomainId, &resp.Diagnostics)
+}
+
+func (r *ReporterResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data reporterModel.ReporterResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var reporter *client.Reporter
+	if data.Default.ValueBool() {
+		// The default reporter is created with the domain or the
+		// organization, it is adopted and updated rather than created.
+		reporter = r.findDefaultReporter(ctx, data, &resp.Diagnostics)
+		if reporter == nil {
+			return
+		}
+		if reporter.Type == nil || *reporter.Type != data.Type.ValueString() {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("type"),
+				"Invalid attribute",
+				fmt.Sprintf("The default reporter is of type %s.", convert.String(reporter.Type).ValueString()),
+			)
+			return
+		}
+		data.ReporterId = convert.String(reporter.Id)
+		reporter = r.updateReporter(ctx, data, &resp.Diagnostics)
+	} else {
+		reporter = r.createReporter(ctx, data, &resp.Diagnostics)
+	}
+	if reporter == nil {
+		return
+	}
+
+	data = reporterModel.MapReporterResource(reporter, data)
+
+	tflog.Trace(ctx, "created a resource")
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ReporterResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data reporterModel.ReporterResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var httpRes *http.Response
+	var err error
+	if data.ReferenceType.ValueString() == "domain" {
+		httpRes, err = r.client.DomainGetReporter(ctx, data.OrganizationId.ValueString(), data.EnvironmentId.ValueString(), data.DomainId.ValueString(), data.ReporterId.ValueString())
+	} else {
+		httpRes, err = doRequest(ctx, r.client, http.MethodGet, organizationReporterPath(data.OrganizationId.ValueString(), data.ReporterId.ValueString()), nil)
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to read item",
+			err.Error(),
+		)
+		return
+	}
+	defer httpRes.Body.Close()
+
+	if httpRes.StatusCode == 404 {
+		tflog.Warn(ctx, "Reporter not found, removing from state", map[string]any{"id": data.Id.ValueString()})
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	if httpRes.StatusCode != 200 {
+		addAPIErrorDiagnostic(&resp.Diagnostics, httpRes)
+		return
+	}
+
+	var apiRes client.Reporter
+	if err := json.NewDecoder(httpRes.Body).Decode(&apiRes); err != nil {
+		resp.Diagnostics.AddError(
+			"Invalid format received",
+			err.Error(),
+		)
+		return
+	}
+
+	data = reporterModel.MapReporterResource(&apiRes, data)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ReporterResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data reporterModel.ReporterResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	reporter := r.updateReporter(ctx, data, &resp.Diagnostics)
+	if reporter == nil {
+		return
+	}
+
+	data = reporterModel.MapReporterResource(reporter, data)
+
+	tflog.Trace(ctx, "updated a resource")
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ReporterResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data reporterModel.ReporterResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data.Default.ValueBool() {
+		tflog.Warn(ctx, "Default reporter left in AM, removing from state", map[string]any{"id": data.Id.ValueString()})
+		return
+	}
+
+	var httpRes *http.Response
+	var err error
+	if data.ReferenceType.ValueString() == "domain" {
+		httpRes, err = r.client.DomainDeleteReporter(ctx, data.OrganizationId.ValueString(), data.EnvironmentId.ValueString(), data.DomainId.ValueString(), data.ReporterId.ValueString())
+	} else {
+		httpRes, err = doRequest(ctx, r.client, http.MethodDelete, organizationReporterPath(data.OrganizationId.ValueString(), data.ReporterId.ValueString()), nil)
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to delete item",
+			err.Error(),
+		)
+		return
+	}
+	defer httpRes.Body.Close()
+
+	if httpRes.StatusCode != 204 && httpRes.StatusCode != 404 {
+		addAPIErrorDiagnostic(&resp.Diagnostics, httpRes)
+		return
+	}
+
+	tflog.Trace(ctx, "deleted a resource")
+}
+
+func (r *ReporterResource) findDefaultReporter(ctx context.Context, data reporterModel.ReporterResourceModel, diags *diag.Diagnostics) *client.Reporter {
+	var httpRes *http.Response
+	var err error
+	if data.ReferenceType.ValueString() == "domain" {
+		httpRes, err = r.client.EnvironmentListDomainReporters(ctx, data.OrganizationId.ValueString(), data.EnvironmentId.ValueString(), data.DomainId.ValueString(), &client.EnvironmentListDomainReportersParams{})
+	} else {
+		httpRes, err = doRequest(ctx, r.client, http.MethodGet, organizationReporterPath(data.OrganizationId.ValueString(), ""), nil)
+	}
+	if err != nil {
+		diags.AddError(
+			"Unable to read item",
+			err.Error(),
+		)
+		return nil
+	}
+	defer httpRes.Body.Close()
+
+	if httpRes.StatusCode != 200 {
+		addAPIErrorDiagnostic(diags, httpRes)
+		return nil
+	}
+
+	var apiRes []client.Reporter
+	if err := json.NewDecoder(httpRes.Body).Decode(&apiRes); err != nil {
+		diags.AddError(
+			"Invalid format received",
+			err.Error(),
+		)
+		return nil
+	}
+
+	reporter := reporterModel.FindDefaultReporter(apiRes)
+	if reporter == nil {
+		reference := "organization " + data.OrganizationId.ValueString()
+		if data.ReferenceType.ValueString() == "domain" {
+			reference = "domain " + data.DomainId.ValueString()
+		}
+		diags.AddAttributeError(
+			path.Root("default"),
+			"Default reporter not found",
+			fmt.Sprintf("The %s has no default reporter.", reference),
+		)
+	}
+	return reporter
+}
+
+func (r *ReporterResource) createReporter(ctx context.Context, data reporterModel.ReporterResourceModel, diags *diag.Diagnostics) *client.Reporter {
+	newReporter := reporterModel.NewReporterFromResource(data)
+
+	var httpRes *http.Response
+	var err error
+	if data.ReferenceType.ValueString() == "domain" {
+		httpRes, err = r.client.EnvironmentCreateDomainReporter(ctx, data.OrganizationId.ValueString(), data.EnvironmentId.ValueString(), data.DomainId.ValueString(), newReporter)
+	} else {
+		httpRes, err = doRequest(ctx, r.client, http.MethodPost, organizationReporterPath(data.OrganizationId.ValueString(), ""), newReporter)
+	}
+	if err != nil {
+		diags.AddError(
+			"Unable to create item",
+			err.Error(),
+		)
+		return nil
+	}
+	defer httpRes.Body.Close()
+
+	if httpRes.StatusCode != 201 {
+		addAPIErrorDiagnostic(diags, httpRes)
+		return nil
+	}
+
+	var apiRes client.Reporter
+	if err := json.NewDecoder(httpRes.Body).Decode(&apiRes); err != nil {
+		diags.AddError(
+			"Invalid format received",
+			err.Error(),
+		)
+		return nil
+	}
+	return &apiRes
+}
+
+func (r *ReporterResource) updateReporter(ctx context.Context, data reporterModel.ReporterResourceModel, diags *diag.Diagnostics) *client.Reporter {
+	update := reporterModel.UpdateReporterFromResource(data)
+
+	var httpRes *http.Response
+	var err error
+	if data.ReferenceType.ValueString() == "domain" {
+		httpRes, err = r.client.DomainUpdateReporter(ctx, data.OrganizationId.ValueString(), data.EnvironmentId.ValueString(), data.DomainId.ValueString(), data.ReporterId.ValueString(), update)
+	} else {
+		httpRes, err = doRequest(ctx, r.client, http.MethodPut, organizationReporterPath(data.OrganizationId.ValueString(), data.ReporterId.ValueString()), update)
+	}
+	if err != nil {
+		diags.AddError(
+			"Unable to update item",
+			err.Error(),
+		)
+		return nil
+	}
+	defer httpRes.Body.Close()
+
+	if !isUpdateSuccess(httpRes) {
+		addAPIErrorDiagnostic(diags, httpRes)
+		return nil
+	}
+
+	var apiRes client.Reporter
+	if err := json.NewDecoder(httpRes.Body).Decode(&apiRes); err != nil {
+		diags.AddError(
+			"Invalid format received",
+			err.Error(),
+		)
+		return nil
+	}
+	return &apiRes
+}
+
+func (r *ReporterResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	referenceType, organizationId, environmentId, domainId, reporterId, idErr := ParseReporterID(req.ID)
+	if idErr != nil {
+		resp.Diagnostics.AddError(
+			"Error parsing id",
+			idErr.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("reference_type"), referenceType)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("organization_id"), organizationId)...)
+	if referenceType == "domain" {
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("environment_id"), environmentId)...)
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("domain_id"), domainId)...)
+	}
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("reporter_id"), reporterId)...)
+}
@@ -0,0 +1,175 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestParseReporterID(t *testing.T) {
+	referenceType, organizationId, environmentId, domainId, reporterId, err := ParseReporterID("DEFAULT:DEFAULT:domain:reporter")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if referenceType != "domain" || organizationId != "DEFAULT" || environmentId != "DEFAULT" || domainId != "domain" || reporterId != "reporter" {
+		t.Errorf("unexpected parts: %s, %s, %s, %s, %s", referenceType, organizationId, environmentId, domainId, reporterId)
+	}
+
+	referenceType, organizationId, _, _, reporterId, err = ParseReporterID("DEFAULT:reporter")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if referenceType != "organization" || organizationId != "DEFAULT" || reporterId != "reporter" {
+		t.Errorf("unexpected parts: %s, %s, %s", referenceType, organizationId, reporterId)
+	}
+
+	for _, id := range []string{"", "DEFAULT", "DEFAULT:", "DEFAULT:DEFAULT:domain", "DEFAULT::domain:reporter"} {
+		if _, _, _, _, _, err := ParseReporterID(id); err == nil {
+			t.Errorf("expected an error for %q", id)
+		}
+	}
+}
+
+func TestOrganizationReporterPath(t *testing.T) {
+	if reporterPath := organizationReporterPath("DEFAULT", ""); reporterPath != "/organizations/DEFAULT/reporters" {
+		t.Errorf("unexpected path: %s", reporterPath)
+	}
+	if reporterPath := organizationReporterPath("DEFAULT", "a/b"); reporterPath != "/organizations/DEFAULT/reporters/a%2Fb" {
+		t.Errorf("unexpected path: %s", reporterPath)
+	}
+}
+
+func TestAccReporterResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + testAccReporterResourceConfig("true"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("graviteeioam_reporter.test", "type", "reporter-am-file"),
+					resource.TestCheckResourceAttr("graviteeioam_reporter.test", "default", "false"),
+					resource.TestCheckResourceAttrSet("graviteeioam_reporter.test", "reporter_id"),
+				),
+			},
+			{
+				ResourceName:      "graviteeioam_reporter.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+				// The imported configuration is normalized by AM.
+				ImportStateVerifyIgnore: []string{"configuration"},
+			},
+			{
+				Config: providerConfig + testAccReporterResourceConfig("false"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("graviteeioam_reporter.test", "enabled", "false"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccReporterResourceDefault(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + testAccReporterResourceDefaultConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("graviteeioam_reporter.default", "default", "true"),
+					resource.TestCheckResourceAttr("graviteeioam_reporter.default", "name", "tf-acc-default"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccReporterResourceOrganization(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + testAccReporterResourceOrganizationConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("graviteeioam_reporter.organization", "reference_type", "organization"),
+					resource.TestCheckNoResourceAttr("graviteeioam_reporter.organization", "domain_id"),
+					resource.TestCheckResourceAttrSet("graviteeioam_reporter.organization", "reporter_id"),
+				),
+			},
+			{
+				ResourceName:            "graviteeioam_reporter.organization",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"configuration"},
+			},
+		},
+	})
+}
+
+func testAccReporterResourceConfig(enabled string) string {
+	return fmt.Sprintf(`
+resource "graviteeioam_domain" "test" {
+  organization_id = "DEFAULT"
+  environment_id  = "DEFAULT"
+  name            = "tf-acc-reporter-domain"
+}
+
+resource "graviteeioam_reporter" "test" {
+  reference_type  = "domain"
+  organization_id = graviteeioam_domain.test.organization_id
+  environment_id  = graviteeioam_domain.test.environment_id
+  domain_id       = graviteeioam_domain.test.domain_id
+  name            = "tf-acc-file"
+  type            = "reporter-am-file"
+  enabled         = %[1]s
+  configuration = jsonencode({
+    filename     = "tf-acc-audit"
+    outputFormat = "JSON"
+  })
+}
+`, enabled)
+}
+
+const testAccReporterResourceDefaultConfig = `
+resource "graviteeioam_domain" "test" {
+  organization_id = "DEFAULT"
+  environment_id  = "DEFAULT"
+  name            = "tf-acc-default-reporter-domain"
+}
+
+resource "graviteeioam_reporter" "default" {
+  reference_type  = "domain"
+  organization_id = graviteeioam_domain.test.organization_id
+  environment_id  = graviteeioam_domain.test.environment_id
+  domain_id       = graviteeioam_domain.test.domain_id
+  default         = true
+  name            = "tf-acc-default"
+  type            = "mongodb"
+  configuration = jsonencode({
+    uri                  = "mongodb://localhost:27017"
+    host                 = "localhost"
+    port                 = 27017
+    enableCredentials    = false
+    database             = "gravitee-am"
+    reportableCollection = "reporter_audits_${graviteeioam_domain.test.domain_id}"
+    bulkActions          = 1000
+    flushInterval        = 5
+  })
+}
+`
+
+const testAccReporterResourceOrganizationConfig = `
+resource "graviteeioam_reporter" "organization" {
+  reference_type  = "organization"
+  organization_id = "DEFAULT"
+  name            = "tf-acc-organization-file"
+  type            = "reporter-am-file"
+  configuration = jsonencode({
+    filename     = "tf-acc-organization-audit"
+    outputFormat = "JSON"
+  })
+}
+`
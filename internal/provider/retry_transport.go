@@ -0,0 +1,154 @@
+package provider
+
+import (
+	"math"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const (
+	defaultMaxRetries   = 3
+	defaultRetryMinWait = 1 * time.Second
+	defaultRetryMaxWait = 30 * time.Second
+)
+
+// providerRetryConfig holds the retry settings for transient management API
+// failures.
+type providerRetryConfig struct {
+	MaxRetries         int
+	MinWait            time.Duration
+	MaxWait            time.Duration
+	RetryNonIdempotent bool
+}
+
+// retryTransport is an http.RoundTripper retrying requests that failed with a
+// transient error, such as a 429 or a 503 while AM is restarting.
+type retryTransport struct {
+	base   http.RoundTripper
+	config providerRetryConfig
+	sleep  func(req *http.Request, wait time.Duration) error
+}
+
+var _ http.RoundTripper = &retryTransport{}
+
+func newRetryTransport(base http.RoundTripper, config providerRetryConfig) *retryTransport {
+	return &retryTransport{
+		base:   base,
+		config: config,
+		sleep:  sleepWithContext,
+	}
+}
+
+func (t *retryTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	retryable := t.config.RetryNonIdempotent || isIdempotentMethod(req.Method)
+	if req.Body != nil && req.GetBody == nil {
+		retryable = false
+	}
+
+	for attempt := 0; ; attempt++ {
+		if attempt > 0 && req.GetBody != nil {
+			body, err := req.GetBody()
+			if err != nil {
+				return nil, err
+			}
+			req = req.Clone(req.Context())
+			req.Body = body
+		}
+
+		res, err := t.base.RoundTrip(req)
+		if !retryable || attempt >= t.config.MaxRetries || !isRetryableResponse(res, err) {
+			return res, err
+		}
+
+		wait := t.backoff(attempt, res)
+		fields := map[string]any{
+			"method":  req.Method,
+			"path":    req.URL.Path,
+			"attempt": attempt + 1,
+			"wait":    wait.String(),
+		}
+		if err != nil {
+			fields["error"] = err.Error()
+		} else {
+			fields["status"] = res.Status
+			res.Body.Close()
+		}
+		tflog.Warn(req.Context(), "Retrying GraviteeIOAM request after a transient failure", fields)
+
+		if sleepErr := t.sleep(req, wait); sleepErr != nil {
+			return nil, sleepErr
+		}
+	}
+}
+
+// backoff returns how long to wait before the next attempt, honouring the
+// Retry-After header when the server sends one.
+func (t *retryTransport) backoff(attempt int, res *http.Response) time.Duration {
+	if res != nil {
+		if retryAfter, ok := parseRetryAfter(res.Header.Get("Retry-After")); ok {
+			if retryAfter < t.config.MinWait {
+				return t.config.MinWait
+			}
+			if retryAfter > t.config.MaxWait {
+				return t.config.MaxWait
+			}
+			return retryAfter
+		}
+	}
+	if t.config.MinWait == 0 {
+		return 0
+	}
+	// A negative wait is an overflow of a large attempt.
+	wait := time.Duration(float64(t.config.MinWait) * math.Pow(2, float64(attempt)))
+	if wait < 0 || wait > t.config.MaxWait {
+		return t.config.MaxWait
+	}
+	return wait
+}
+
+func isIdempotentMethod(method string) bool {
+	switch method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions, http.MethodPut, http.MethodDelete:
+		return true
+	}
+	return false
+}
+
+func isRetryableResponse(res *http.Response, err error) bool {
+	if err != nil {
+		return true
+	}
+	switch res.StatusCode {
+	case http.StatusTooManyRequests, http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+		return true
+	}
+	return false
+}
+
+func parseRetryAfter(value string) (time.Duration, bool) {
+	if value == "" {
+		return 0, false
+	}
+	if seconds, err := strconv.Atoi(value); err == nil && seconds >= 0 {
+		return time.Duration(seconds) * time.Second, true
+	}
+	if date, err := http.ParseTime(value); err == nil {
+		return time.Until(date), true
+	}
+	return 0, false
+}
+
+func sleepWithContext(req *http.Request, wait time.Duration) error {
+	timer := time.NewTimer(wait)
+	defer timer.Stop()
+
+	select {
+	case <-req.Context().Done():
+		return req.Context().Err()
+	case <-timer.C:
+		return nil
+	}
+}
@@ -0,0 +1,110 @@
+package provider
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+func testRetryClient(t *testing.T, config providerRetryConfig, waits *[]time.Duration) *http.Client {
+	t.Helper()
+	transport := newRetryTransport(http.DefaultTransport, config)
+	transport.sleep = func(req *http.Request, wait time.Duration) error {
+		*waits = append(*waits, wait)
+		return nil
+	}
+	return &http.Client{Transport: transport}
+}
+
+func TestRetryTransportRetriesTransientFailures(t *testing.T) {
+	var bodies []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		bodies = append(bodies, string(body))
+		switch len(bodies) {
+		case 1:
+			w.Header().Set("Retry-After", "5")
+			w.WriteHeader(http.StatusTooManyRequests)
+		case 2:
+			w.WriteHeader(http.StatusServiceUnavailable)
+		default:
+			w.WriteHeader(http.StatusOK)
+		}
+	}))
+	defer server.Close()
+
+	var waits []time.Duration
+	httpClient := testRetryClient(t, providerRetryConfig{MaxRetries: 3, MinWait: time.Second, MaxWait: 30 * time.Second}, &waits)
+
+	req, _ := http.NewRequest(http.MethodPut, server.URL, strings.NewReader("payload"))
+	res, err := httpClient.Do(req)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	res.Body.Close()
+
+	if res.StatusCode != http.StatusOK {
+		t.Fatalf("expected the request to eventually succeed, got %s", res.Status)
+	}
+	if len(bodies) != 3 || bodies[2] != "payload" {
+		t.Fatalf("expected the body to be replayed on each of the 3 attempts, got %q", bodies)
+	}
+	if len(waits) != 2 || waits[0] != 5*time.Second || waits[1] != 2*time.Second {
+		t.Fatalf("expected waits of 5s (Retry-After) then 2s (backoff), got %v", waits)
+	}
+}
+
+func TestRetryTransportBackoff(t *testing.T) {
+	for name, tc := range map[string]struct {
+		config   providerRetryConfig
+		attempt  int
+		expected time.Duration
+	}{
+		"first attempt":                    {providerRetryConfig{MinWait: time.Second, MaxWait: 30 * time.Second}, 0, time.Second},
+		"doubled":                          {providerRetryConfig{MinWait: time.Second, MaxWait: 30 * time.Second}, 2, 4 * time.Second},
+		"capped":                           {providerRetryConfig{MinWait: time.Second, MaxWait: 30 * time.Second}, 10, 30 * time.Second},
+		"overflow":                         {providerRetryConfig{MinWait: time.Second, MaxWait: 30 * time.Second}, 100, 30 * time.Second},
+		"zero minimum wait":                {providerRetryConfig{MinWait: 0, MaxWait: 30 * time.Second}, 0, 0},
+		"zero minimum wait, later attempt": {providerRetryConfig{MinWait: 0, MaxWait: 30 * time.Second}, 3, 0},
+	} {
+		if wait := newRetryTransport(http.DefaultTransport, tc.config).backoff(tc.attempt, nil); wait != tc.expected {
+			t.Errorf("%s: expected %s, got %s", name, tc.expected, wait)
+		}
+	}
+}
+
+func TestRetryTransportSkipsNonIdempotentRequests(t *testing.T) {
+	calls := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		w.WriteHeader(http.StatusBadGateway)
+	}))
+	defer server.Close()
+
+	var waits []time.Duration
+	httpClient := testRetryClient(t, providerRetryConfig{MaxRetries: 3, MinWait: time.Second, MaxWait: time.Second}, &waits)
+
+	res, err := httpClient.Post(server.URL, "application/json", strings.NewReader("{}"))
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	res.Body.Close()
+
+	if calls != 1 {
+		t.Fatalf("expected POST not to be retried by default, got %d calls", calls)
+	}
+
+	calls = 0
+	res, err = httpClient.Get(server.URL)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	res.Body.Close()
+
+	if calls != 4 || res.StatusCode != http.StatusBadGateway {
+		t.Fatalf("expected GET to be attempted 4 times and return the last failure, got %d calls and %s", calls, res.Status)
+	}
+}
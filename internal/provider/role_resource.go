@@ -0,0 +1,342 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/thornleyk/graviteeioam-service/client"
+	roleModel "github.com/thornleyk/terraform-provider-graviteeioam/internal/model/role"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var _ resource.Resource = &RoleResource{}
+var _ resource.ResourceWithImportState = &RoleResource{}
+var _ resource.ResourceWithValidateConfig = &RoleResource{}
+
+func NewRoleResource() resource.Resource {
+	return &RoleResource{}
+}
+
+type RoleResource struct {
+	client *client.Client
+}
+
+// ParseRoleID parses the id of a domain role, organizationId:environmentId:domainId:roleId,
+// or of an organization role, organizationId:roleId, and returns its reference type.
+func ParseRoleID(id string) (string, string, string, string, string, error) {
+	return parseReferenceID(id, "roleId")
+}
+
+// parseReferenceID parses the id of an item of a domain, organizationId:environmentId:domainId:itemId,
+// or of an organization, organizationId:itemId, and returns its reference type.
+func parseReferenceID(id string, item string) (string, string, string, string, string, error) {
+	parts := strings.Split(id, ":")
+	if !containsString(parts, "") {
+		switch len(parts) {
+		case 2:
+			return "organization", parts[0], "", "", parts[1], nil
+		case 4:
+			return "domain", parts[0], parts[1], parts[2], parts[3], nil
+		}
+	}
+	return "", "", "", "", "", fmt.Errorf("unexpected format of ID (%s), expected organizationId:environmentId:domainId:%s or organizationId:%s", id, item, item)
+}
+
+func (r *RoleResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_role"
+}
+
+func (r *RoleResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = *roleModel.GetRoleResourceSchema()
+}
+
+func (r *RoleResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *RoleResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data roleModel.RoleResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	validateReference("roles", data.ReferenceType, data.EnvironmentId, data.DomainId, &resp.Diagnostics)
+}
+
+func (r *RoleResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data roleModel.RoleResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	newRole := roleModel.NewRoleFromResource(data)
+
+	var httpRes *http.Response
+	var err error
+	if data.ReferenceType.ValueString() == "domain" {
+		httpRes, err = r.client.EnvironmentCreateDomainMemberRole(ctx, data.OrganizationId.ValueString(), data.EnvironmentId.ValueString(), data.DomainId.ValueString(), newRole)
+	} else {
+		httpRes, err = r.client.OrganizationCreatePlatformRole(ctx, data.OrganizationId.ValueString(), newRole)
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to create item",
+			err.Error(),
+		)
+		return
+	}
+	defer httpRes.Body.Close()
+
+	if httpRes.StatusCode != 201 {
+		addAPIErrorDiagnostic(&resp.Diagnostics, httpRes)
+		return
+	}
+
+	var apiRes client.RoleEntity
+	if err := json.NewDecoder(httpRes.Body).Decode(&apiRes); err != nil {
+		resp.Diagnostics.AddError(
+			"Invalid format received",
+			err.Error(),
+		)
+		return
+	}
+
+	// The create endpoint does not accept the permissions, they are applied
+	// with a follow-up update.
+	update, diags := roleModel.UpdateRoleFromResource(ctx, data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	updated, ok := r.updateRole(ctx, data, *apiRes.Id, update, &resp.Diagnostics)
+	if !ok {
+		// Keep the created role in state so that it is not orphaned.
+		data = roleModel.MapRoleResource(&apiRes, data)
+		resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+		return
+	}
+
+	data = roleModel.MapRoleResource(updated, data)
+
+	tflog.Trace(ctx, "created a resource")
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *RoleResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data roleModel.RoleResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var httpRes *http.Response
+	var err error
+	if data.ReferenceType.ValueString() == "domain" {
+		httpRes, err = r.client.DomainGetRole(ctx, data.OrganizationId.ValueString(), data.EnvironmentId.ValueString(), data.DomainId.ValueString(), data.RoleId.ValueString())
+	} else {
+		httpRes, err = r.client.OrganizationGetPlatformRole(ctx, data.OrganizationId.ValueString(), data.RoleId.ValueString())
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to read item",
+			err.Error(),
+		)
+		return
+	}
+	defer httpRes.Body.Close()
+
+	if httpRes.StatusCode == 404 {
+		tflog.Warn(ctx, "Role not found, removing from state", map[string]any{"id": data.Id.ValueString()})
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	if httpRes.StatusCode != 200 {
+		addAPIErrorDiagnostic(&resp.Diagnostics, httpRes)
+		return
+	}
+
+	var apiRes client.RoleEntity
+	if err := json.NewDecoder(httpRes.Body).Decode(&apiRes); err != nil {
+		resp.Diagnostics.AddError(
+			"Invalid format received",
+			err.Error(),
+		)
+		return
+	}
+
+	data = roleModel.MapRoleResource(&apiRes, data)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *RoleResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data roleModel.RoleResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	update, diags := roleModel.UpdateRoleFromResource(ctx, data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	apiRes, ok := r.updateRole(ctx, data, data.RoleId.ValueString(), update, &resp.Diagnostics)
+	if !ok {
+		return
+	}
+
+	data = roleModel.MapRoleResource(apiRes, data)
+
+	tflog.Trace(ctx, "updated a resource")
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *RoleResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data roleModel.RoleResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var httpRes *http.Response
+	var err error
+	if data.ReferenceType.ValueString() == "domain" {
+		httpRes, err = r.client.DomainDeleteRole(ctx, data.OrganizationId.ValueString(), data.EnvironmentId.ValueString(), data.DomainId.ValueString(), data.RoleId.ValueString())
+	} else {
+		httpRes, err = r.client.OrganizationDeletePlatformRole(ctx, data.OrganizationId.ValueString(), data.RoleId.ValueString())
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to delete item",
+			err.Error(),
+		)
+		return
+	}
+	defer httpRes.Body.Close()
+
+	if httpRes.StatusCode != 204 && httpRes.StatusCode != 404 {
+		addAPIErrorDiagnostic(&resp.Diagnostics, httpRes)
+		return
+	}
+
+	tflog.Trace(ctx, "deleted a resource")
+}
+
+func (r *RoleResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	referenceType, organizationId, environmentId, domainId, roleId, idErr := ParseRoleID(req.ID)
+	if idErr != nil {
+		resp.Diagnostics.AddError(
+			"Error parsing id",
+			idErr.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("reference_type"), referenceType)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("organization_id"), organizationId)...)
+	if referenceType == "domain" {
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("environment_id"), environmentId)...)
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("domain_id"), domainId)...)
+	}
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("role_id"), roleId)...)
+}
+
+func (r *RoleResource) updateRole(ctx context.Context, data roleModel.RoleResourceModel, roleId string, update client.UpdateRole, diags *diag.Diagnostics) (*client.RoleEntity, bool) {
+	var httpRes *http.Response
+	var err error
+	if data.ReferenceType.ValueString() == "domain" {
+		httpRes, err = r.client.DomainUpdateRole(ctx, data.OrganizationId.ValueString(), data.EnvironmentId.ValueString(), data.DomainId.ValueString(), roleId, update)
+	} else {
+		httpRes, err = r.client.OrganizationUpdatePlatformRole(ctx, data.OrganizationId.ValueString(), roleId, update)
+	}
+	if err != nil {
+		diags.AddError(
+			"Unable to update item",
+			err.Error(),
+		)
+		return nil, false
+	}
+	defer httpRes.Body.Close()
+
+	if !isUpdateSuccess(httpRes) {
+		addAPIErrorDiagnostic(diags, httpRes)
+		return nil, false
+	}
+
+	var apiRes client.RoleEntity
+	if err := json.NewDecoder(httpRes.Body).Decode(&apiRes); err != nil {
+		diags.AddError(
+			"Invalid format received",
+			err.Error(),
+		)
+		return nil, false
+	}
+	return &apiRes, true
+}
+
+// validateReference checks that the environment and the domain are set for
+// items of a domain only, items names the kind of item in the messages such
+// as roles.
+func validateReference(items string, referenceType types.String, environmentId types.String, domainId types.String, diags *diag.Diagnostics) {
+	if referenceType.IsUnknown() {
+		return
+	}
+
+	domainReference := referenceType.ValueString() == "domain"
+	for _, attribute := range []struct {
+		name  string
+		value types.String
+	}{
+		{"environment_id", environmentId},
+		{"domain_id", domainId},
+	} {
+		if domainReference && attribute.value.IsNull() {
+			diags.AddAttributeError(path.Root(attribute.name), "Missing attribute", fmt.Sprintf("%s is required for domain %s.", attribute.name, items))
+		}
+		if !domainReference && !attribute.value.IsNull() {
+			diags.AddAttributeError(path.Root(attribute.name), "Invalid attribute", fmt.Sprintf("%s is only supported by domain %s.", attribute.name, items))
+		}
+	}
+}
@@ -0,0 +1,103 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestParseRoleID(t *testing.T) {
+	referenceType, organizationId, environmentId, domainId, roleId, err := ParseRoleID("DEFAULT:DEFAULT:domain:role")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if referenceType != "domain" || organizationId != "DEFAULT" || environmentId != "DEFAULT" || domainId != "domain" || roleId != "role" {
+		t.Errorf("unexpected parts: %s, %s, %s, %s, %s", referenceType, organizationId, environmentId, domainId, roleId)
+	}
+
+	referenceType, organizationId, _, _, roleId, err = ParseRoleID("DEFAULT:role")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if referenceType != "organization" || organizationId != "DEFAULT" || roleId != "role" {
+		t.Errorf("unexpected parts: %s, %s, %s", referenceType, organizationId, roleId)
+	}
+
+	for _, id := range []string{"", "DEFAULT", "DEFAULT:", "DEFAULT:DEFAULT:domain", "DEFAULT::domain:role", "DEFAULT:DEFAULT:domain:role:extra"} {
+		if _, _, _, _, _, err := ParseRoleID(id); err == nil {
+			t.Errorf("expected an error for %q", id)
+		}
+	}
+}
+
+func TestAccRoleResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + testAccRoleResourceConfig("READ"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("graviteeioam_role.domain", "name", "tf-acc-application-owner"),
+					resource.TestCheckResourceAttr("graviteeioam_role.domain", "assignable_type", "APPLICATION"),
+					resource.TestCheckResourceAttr("graviteeioam_role.domain", "permissions.APPLICATION.#", "1"),
+					resource.TestCheckResourceAttr("graviteeioam_role.domain", "system", "false"),
+					resource.TestCheckResourceAttrSet("graviteeioam_role.domain", "role_id"),
+					resource.TestCheckResourceAttr("graviteeioam_role.organization", "assignable_type", "ORGANIZATION"),
+					resource.TestCheckResourceAttr("graviteeioam_role.organization", "permissions.ORGANIZATION_AUDIT.#", "2"),
+				),
+			},
+			{
+				ResourceName:      "graviteeioam_role.domain",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				ResourceName:      "graviteeioam_role.organization",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: providerConfig + testAccRoleResourceConfig("UPDATE"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("graviteeioam_role.domain", "permissions.APPLICATION.#", "2"),
+					resource.TestCheckTypeSetElemAttr("graviteeioam_role.domain", "permissions.APPLICATION.*", "UPDATE"),
+				),
+			},
+		},
+	})
+}
+
+func testAccRoleResourceConfig(acl string) string {
+	return fmt.Sprintf(`
+resource "graviteeioam_domain" "test" {
+  organization_id = "DEFAULT"
+  environment_id  = "DEFAULT"
+  name            = "tf-acc-role-domain"
+}
+
+resource "graviteeioam_role" "domain" {
+  reference_type  = "domain"
+  organization_id = graviteeioam_domain.test.organization_id
+  environment_id  = graviteeioam_domain.test.environment_id
+  domain_id       = graviteeioam_domain.test.domain_id
+  name            = "tf-acc-application-owner"
+  description     = "Delegated application administration"
+  assignable_type = "APPLICATION"
+  permissions = {
+    APPLICATION = distinct(["READ", %[1]q])
+  }
+}
+
+resource "graviteeioam_role" "organization" {
+  reference_type  = "organization"
+  organization_id = "DEFAULT"
+  name            = "tf-acc-auditor"
+  assignable_type = "ORGANIZATION"
+  permissions = {
+    ORGANIZATION_AUDIT = ["LIST", "READ"]
+  }
+}
+`, acl)
+}
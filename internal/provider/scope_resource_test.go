@@ -0,0 +1,67 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccScopeResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + testAccScopeResourceConfig("Read orders", "email"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("graviteeioam_scope.test", "key", "tf-acc:orders"),
+					resource.TestCheckResourceAttr("graviteeioam_scope.test", "name", "Read orders"),
+					resource.TestCheckResourceAttr("graviteeioam_scope.test", "claims.#", "1"),
+					resource.TestCheckResourceAttr("graviteeioam_scope.test", "system", "false"),
+					resource.TestCheckResourceAttrSet("graviteeioam_scope.test", "scope_id"),
+					resource.TestCheckTypeSetElemAttr("data.graviteeioam_scopes.test", "keys.*", "tf-acc:orders"),
+				),
+			},
+			{
+				ResourceName:      "graviteeioam_scope.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: providerConfig + testAccScopeResourceConfig("Read and list orders", "name"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("graviteeioam_scope.test", "name", "Read and list orders"),
+					resource.TestCheckTypeSetElemAttr("graviteeioam_scope.test", "claims.*", "name"),
+				),
+			},
+		},
+	})
+}
+
+func testAccScopeResourceConfig(name string, claim string) string {
+	return fmt.Sprintf(`
+resource "graviteeioam_domain" "test" {
+  organization_id = "DEFAULT"
+  environment_id  = "DEFAULT"
+  name            = "tf-acc-scope-domain"
+}
+
+resource "graviteeioam_scope" "test" {
+  organization_id = graviteeioam_domain.test.organization_id
+  environment_id  = graviteeioam_domain.test.environment_id
+  domain_id       = graviteeioam_domain.test.domain_id
+  key             = "tf-acc:orders"
+  name            = %[1]q
+  description     = "Access to the orders API"
+  expires_in      = 3600
+  claims          = [%[2]q]
+}
+
+data "graviteeioam_scopes" "test" {
+  organization_id = graviteeioam_scope.test.organization_id
+  environment_id  = graviteeioam_scope.test.environment_id
+  domain_id       = graviteeioam_scope.test.domain_id
+}
+`, name, claim)
+}
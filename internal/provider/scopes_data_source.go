@@ -0,0 +1,89 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/thornleyk/graviteeioam-service/client"
+	scopeModel "github.com/thornleyk/terraform-provider-graviteeioam/internal/model/scope"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var _ datasource.DataSource = &ScopesDataSource{}
+
+func NewScopesDataSource() datasource.DataSource {
+	return &ScopesDataSource{}
+}
+
+type ScopesDataSource struct {
+	client *client.Client
+}
+
+func (d *ScopesDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_scopes"
+}
+
+func (d *ScopesDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = *scopeModel.GetScopesDataSourceSchema()
+}
+
+func (d *ScopesDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+func (d *ScopesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data scopeModel.ScopesDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	organizationId := data.OrganizationId.ValueString()
+	environmentId := data.EnvironmentId.ValueString()
+	domainId := data.DomainId.ValueString()
+
+	apiRes, err := listAllPages[client.Scope](ctx, func(ctx context.Context, page int32, size int32) (*http.Response, error) {
+		return d.client.EnvironmentListDomainScopesPaginated(ctx, organizationId, environmentId, domainId, &client.EnvironmentListDomainScopesPaginatedParams{
+			Page: &page,
+			Size: &size,
+			Q:    data.Query.ValueStringPointer(),
+		})
+	})
+	if err != nil {
+		addErrorDiagnostic(&resp.Diagnostics, "Unable to read item", err)
+		return
+	}
+
+	data, mapErr := scopeModel.MapScopesDataSource(apiRes, data)
+	if mapErr != nil {
+		resp.Diagnostics.AddError(
+			"Unable to read data source",
+			mapErr.Error(),
+		)
+		return
+	}
+
+	tflog.Trace(ctx, "read a data source")
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
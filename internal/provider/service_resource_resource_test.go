@@ -0,0 +1,67 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccServiceResourceResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + testAccServiceResourceResourceConfig("secret"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("graviteeioam_service_resource.test", "type", "smtp-am-resource"),
+					resource.TestCheckResourceAttrSet("graviteeioam_service_resource.test", "resource_id"),
+					resource.TestCheckResourceAttrSet("graviteeioam_service_resource.test", "sensitive_configuration_hash"),
+				),
+			},
+			{
+				ResourceName:      "graviteeioam_service_resource.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+				// AM masks the sensitive configuration, it is not imported.
+				ImportStateVerifyIgnore: []string{"configuration", "sensitive_configuration", "sensitive_configuration_hash"},
+			},
+			{
+				Config: providerConfig + testAccServiceResourceResourceConfig("changed"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("graviteeioam_service_resource.test", "sensitive_configuration.password", "changed"),
+				),
+			},
+		},
+	})
+}
+
+func testAccServiceResourceResourceConfig(password string) string {
+	return fmt.Sprintf(`
+resource "graviteeioam_domain" "test" {
+  organization_id = "DEFAULT"
+  environment_id  = "DEFAULT"
+  name            = "tf-acc-service-resource-domain"
+}
+
+resource "graviteeioam_service_resource" "test" {
+  organization_id = graviteeioam_domain.test.organization_id
+  environment_id  = graviteeioam_domain.test.environment_id
+  domain_id       = graviteeioam_domain.test.domain_id
+  name            = "tf-acc-smtp"
+  type            = "smtp-am-resource"
+  configuration = jsonencode({
+    host           = "smtp.example.com"
+    port           = 587
+    from           = "no-reply@example.com"
+    protocol       = "smtp"
+    authentication = true
+    username       = "no-reply@example.com"
+  })
+  sensitive_configuration = {
+    password = %[1]q
+  }
+}
+`, password)
+}
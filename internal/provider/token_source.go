@@ -0,0 +1,182 @@
+package provider
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/thornleyk/graviteeioam-service/client"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// tokenRefreshSkew is how long before the recorded expiry a token is
+// considered stale and gets refreshed.
+const tokenRefreshSkew = 30 * time.Second
+
+// tokenAuthenticator obtains a fresh access token from Gravitee AM.
+type tokenAuthenticator func(ctx context.Context) (*client.AuthToken, error)
+
+// tokenSource keeps a bearer token for the management API up to date. It is
+// used both as the request editor that sets the Authorization header and as
+// the HTTP doer that retries a request once after re-authenticating on a 401.
+type tokenSource struct {
+	authenticate tokenAuthenticator
+	doer         client.HttpRequestDoer
+	now          func() time.Time
+
+	mu     sync.Mutex
+	token  string
+	expiry time.Time
+}
+
+var _ client.HttpRequestDoer = &tokenSource{}
+
+func newTokenSource(authenticate tokenAuthenticator, doer client.HttpRequestDoer) *tokenSource {
+	return &tokenSource{
+		authenticate: authenticate,
+		doer:         doer,
+		now:          time.Now,
+	}
+}
+
+// Token returns the current access token, authenticating first when there
+// is no token yet or it is about to expire.
+func (s *tokenSource) Token(ctx context.Context) (string, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.token != "" && (s.expiry.IsZero() || s.now().Add(tokenRefreshSkew).Before(s.expiry)) {
+		return s.token, nil
+	}
+	return s.refreshLocked(ctx)
+}
+
+// Invalidate drops the given token so the next call to Token re-authenticates.
+// Tokens that were already replaced by a concurrent refresh are left alone.
+func (s *tokenSource) Invalidate(token string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.token == token {
+		s.token = ""
+		s.expiry = time.Time{}
+	}
+}
+
+func (s *tokenSource) refreshLocked(ctx context.Context) (string, error) {
+	authToken, err := s.authenticate(ctx)
+	if err != nil {
+		return "", err
+	}
+	if authToken.AccessToken == nil || *authToken.AccessToken == "" {
+		return "", fmt.Errorf("no access token returned by the token exchange")
+	}
+
+	s.token = *authToken.AccessToken
+	s.expiry = tokenExpiry(authToken)
+	tflog.Debug(ctx, "Refreshed GraviteeIOAM access token", map[string]any{"expires_at": s.expiry.String()})
+	return s.token, nil
+}
+
+// Intercept is a client.RequestEditorFn setting the bearer token on requests.
+func (s *tokenSource) Intercept(ctx context.Context, req *http.Request) error {
+	token, err := s.Token(ctx)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Authorization", "Bearer "+token)
+	return nil
+}
+
+// Do sends the request and, when the management API rejects the token with
+// a 401, re-authenticates and replays the request once.
+func (s *tokenSource) Do(req *http.Request) (*http.Response, error) {
+	res, err := s.doer.Do(req)
+	if err != nil || res.StatusCode != http.StatusUnauthorized {
+		return res, err
+	}
+	if req.Body != nil && req.GetBody == nil {
+		return res, nil
+	}
+
+	ctx := req.Context()
+	s.Invalidate(strings.TrimPrefix(req.Header.Get("Authorization"), "Bearer "))
+
+	retry := req.Clone(ctx)
+	if req.GetBody != nil {
+		body, bodyErr := req.GetBody()
+		if bodyErr != nil {
+			return res, nil
+		}
+		retry.Body = body
+	}
+	if err := s.Intercept(ctx, retry); err != nil {
+		return res, nil
+	}
+
+	tflog.Debug(ctx, "Retrying GraviteeIOAM request after re-authentication", map[string]any{"method": req.Method, "path": req.URL.Path})
+	res.Body.Close()
+	return s.doer.Do(retry)
+}
+
+// tokenExpiry works out when a token expires, from the expires_at field of
+// the token exchange response or the exp claim of the JWT itself. A zero
+// time means the expiry is unknown and the token is only refreshed on a 401.
+func tokenExpiry(token *client.AuthToken) time.Time {
+	if token.ExpiresAt != nil {
+		if expiry, ok := parseExpiresAt(*token.ExpiresAt); ok {
+			return expiry
+		}
+	}
+	if token.AccessToken != nil {
+		if expiry, ok := jwtExpiry(*token.AccessToken); ok {
+			return expiry
+		}
+	}
+	return time.Time{}
+}
+
+func parseExpiresAt(value string) (time.Time, bool) {
+	value = strings.TrimSpace(value)
+	if value == "" {
+		return time.Time{}, false
+	}
+	if epoch, err := strconv.ParseInt(value, 10, 64); err == nil {
+		// Gravitee serialises dates as epoch milliseconds.
+		if epoch > 1e12 {
+			return time.UnixMilli(epoch), true
+		}
+		return time.Unix(epoch, 0), true
+	}
+	for _, layout := range []string{time.RFC3339, time.RFC1123, time.UnixDate, time.RubyDate} {
+		if expiry, err := time.Parse(layout, value); err == nil {
+			return expiry, true
+		}
+	}
+	return time.Time{}, false
+}
+
+func jwtExpiry(token string) (time.Time, bool) {
+	parts := strings.Split(token, ".")
+	if len(parts) != 3 {
+		return time.Time{}, false
+	}
+	payload, err := base64.RawURLEncoding.DecodeString(strings.TrimRight(parts[1], "="))
+	if err != nil {
+		return time.Time{}, false
+	}
+	var claims struct {
+		Exp *int64 `json:"exp"`
+	}
+	if err := json.Unmarshal(payload, &claims); err != nil || claims.Exp == nil {
+		return time.Time{}, false
+	}
+	return time.Unix(*claims.Exp, 0), true
+}
@@ -0,0 +1,96 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/thornleyk/graviteeioam-service/client"
+)
+
+func testTokenAuthenticator(calls *int, expiresAt string) tokenAuthenticator {
+	return func(ctx context.Context) (*client.AuthToken, error) {
+		*calls++
+		accessToken := fmt.Sprintf("token-%d", *calls)
+		return &client.AuthToken{AccessToken: &accessToken, ExpiresAt: &expiresAt}, nil
+	}
+}
+
+func TestTokenSourceRefreshesBeforeExpiry(t *testing.T) {
+	calls := 0
+	now := time.Date(2023, 11, 15, 10, 0, 0, 0, time.UTC)
+	source := newTokenSource(testTokenAuthenticator(&calls, now.Add(time.Minute).Format(time.RFC3339)), http.DefaultClient)
+	source.now = func() time.Time { return now }
+
+	for i := 0; i < 2; i++ {
+		token, err := source.Token(context.Background())
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+		if token != "token-1" {
+			t.Fatalf("expected token-1, got %s", token)
+		}
+	}
+
+	now = now.Add(45 * time.Second)
+	token, err := source.Token(context.Background())
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if token != "token-2" {
+		t.Fatalf("expected the token to be refreshed within the skew, got %s", token)
+	}
+}
+
+func TestTokenSourceRetriesOnceOnUnauthorized(t *testing.T) {
+	var requests []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests = append(requests, r.Header.Get("Authorization"))
+		if r.Header.Get("Authorization") != "Bearer token-2" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	calls := 0
+	source := newTokenSource(testTokenAuthenticator(&calls, ""), server.Client())
+
+	req, _ := http.NewRequest(http.MethodPost, server.URL, strings.NewReader(`{"name":"test"}`))
+	if err := source.Intercept(context.Background(), req); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	res, err := source.Do(req)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if res.StatusCode != http.StatusOK {
+		t.Fatalf("expected the retried request to succeed, got %s", res.Status)
+	}
+	if len(requests) != 2 || calls != 2 {
+		t.Fatalf("expected one retry after one re-authentication, got %d requests and %d authentications", len(requests), calls)
+	}
+}
+
+func TestTokenExpiry(t *testing.T) {
+	expected := time.Date(2023, 11, 15, 10, 0, 0, 0, time.UTC)
+	for _, value := range []string{"1700042400000", "1700042400", "2023-11-15T10:00:00Z", "Wed Nov 15 10:00:00 UTC 2023"} {
+		expiresAt := value
+		expiry := tokenExpiry(&client.AuthToken{ExpiresAt: &expiresAt})
+		if !expiry.Equal(expected) {
+			t.Errorf("expires_at %q: expected %s, got %s", value, expected, expiry)
+		}
+	}
+
+	// eyJleHAiOjE3MDAwNDI0MDB9 is {"exp":1700042400}
+	accessToken := "eyJhbGciOiJub25lIn0.eyJleHAiOjE3MDAwNDI0MDB9.sig"
+	if expiry := tokenExpiry(&client.AuthToken{AccessToken: &accessToken}); !expiry.Equal(expected) {
+		t.Errorf("jwt exp: expected %s, got %s", expected, expiry)
+	}
+}
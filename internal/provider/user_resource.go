@@ -0,0 +1,371 @@
+package provider
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/thornleyk/graviteeioam-service/client"
+	userModel "github.com/thornleyk/terraform-provider-graviteeioam/internal/model/user"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var _ resource.Resource = &UserResource{}
+var _ resource.ResourceWithImportState = &UserResource{}
+var _ resource.ResourceWithValidateConfig = &UserResource{}
+
+func NewUserResource() resource.Resource {
+	return &UserResource{}
+}
+
+type UserResource struct {
+	client *client.Client
+}
+
+// ParseUserID parses the id of a domain user, organizationId:environmentId:domainId:userId,
+// or of an organization user, organizationId:userId, and returns its reference type.
+func ParseUserID(id string) (string, string, string, string, string, error) {
+	return parseReferenceID(id, "userId")
+}
+
+func (r *UserResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_user"
+}
+
+func (r *UserResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = *userModel.GetUserResourceSchema()
+}
+
+func (r *UserResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *UserResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data userModel.UserResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	validateReference("users", data.ReferenceType, data.EnvironmentId, data.DomainId, &resp.Diagnostics)
+}
+
+func (r *UserResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data userModel.UserResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	newUser, diags := userModel.NewUserFromResource(ctx, data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	body, err := json.Marshal(newUser)
+	if err != nil {
+		addErrorDiagnostic(&resp.Diagnostics, "Unable to create item", err)
+		return
+	}
+
+	var httpRes *http.Response
+	if data.ReferenceType.ValueString() == "domain" {
+		httpRes, err = r.client.EnvironmentCreateDomainUserWithBody(ctx, data.OrganizationId.ValueString(), data.EnvironmentId.ValueString(), data.DomainId.ValueString(), "application/json", bytes.NewReader(body))
+	} else {
+		httpRes, err = r.client.OrganizationCreatePlatformUserWithBody(ctx, data.OrganizationId.ValueString(), "application/json", bytes.NewReader(body))
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to create item",
+			err.Error(),
+		)
+		return
+	}
+	defer httpRes.Body.Close()
+
+	if httpRes.StatusCode != 201 {
+		addAPIErrorDiagnostic(&resp.Diagnostics, httpRes)
+		return
+	}
+
+	var apiRes userModel.UserEntity
+	if err := json.NewDecoder(httpRes.Body).Decode(&apiRes); err != nil {
+		resp.Diagnostics.AddError(
+			"Invalid format received",
+			err.Error(),
+		)
+		return
+	}
+
+	data = userModel.MapUserResource(&apiRes, data)
+
+	tflog.Trace(ctx, "created a resource")
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *UserResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data userModel.UserResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	apiRes, ok := r.readUser(ctx, data, &resp.Diagnostics)
+	if !ok {
+		return
+	}
+
+	if apiRes == nil {
+		tflog.Warn(ctx, "User not found, removing from state", map[string]any{"id": data.Id.ValueString()})
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	data = userModel.MapUserResource(apiRes, data)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *UserResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data, state userModel.UserResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !data.Username.Equal(state.Username) {
+		username := data.Username.ValueString()
+		if !r.send(ctx, data, "username", client.UsernameEntity{Username: &username}, &resp.Diagnostics) {
+			return
+		}
+	}
+
+	// The update replaces the additional information, the current one is
+	// merged with the configuration.
+	current, ok := r.readUser(ctx, data, &resp.Diagnostics)
+	if !ok {
+		return
+	}
+	if current == nil {
+		resp.Diagnostics.AddError(
+			"Unable to update item",
+			fmt.Sprintf("User %s not found", data.Id.ValueString()),
+		)
+		return
+	}
+
+	update, diags := userModel.UpdateUserFromResource(ctx, data, state, current)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !r.send(ctx, data, "", update, &resp.Diagnostics) {
+		return
+	}
+
+	if !data.Enabled.Equal(state.Enabled) {
+		enabled := data.Enabled.ValueBool()
+		if !r.send(ctx, data, "status", client.StatusEntity{Enabled: &enabled}, &resp.Diagnostics) {
+			return
+		}
+	}
+
+	if data.ResetPasswordOnChange.ValueBool() && !data.Password.IsNull() && !data.Password.Equal(state.Password) {
+		if !r.send(ctx, data, "resetPassword", client.PasswordValue{Password: data.Password.ValueString()}, &resp.Diagnostics) {
+			return
+		}
+	}
+
+	apiRes, ok := r.readUser(ctx, data, &resp.Diagnostics)
+	if !ok {
+		return
+	}
+	if apiRes == nil {
+		resp.Diagnostics.AddError(
+			"Unable to update item",
+			fmt.Sprintf("User %s not found", data.Id.ValueString()),
+		)
+		return
+	}
+
+	data = userModel.MapUserResource(apiRes, data)
+
+	tflog.Trace(ctx, "updated a resource")
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *UserResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data userModel.UserResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var httpRes *http.Response
+	var err error
+	if data.ReferenceType.ValueString() == "domain" {
+		httpRes, err = r.client.DomainDeleteUser(ctx, data.OrganizationId.ValueString(), data.EnvironmentId.ValueString(), data.DomainId.ValueString(), data.UserId.ValueString())
+	} else {
+		httpRes, err = r.client.OrganizationDeletePlatformUser(ctx, data.OrganizationId.ValueString(), data.UserId.ValueString())
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to delete item",
+			err.Error(),
+		)
+		return
+	}
+	defer httpRes.Body.Close()
+
+	if httpRes.StatusCode != 204 && httpRes.StatusCode != 404 {
+		addAPIErrorDiagnostic(&resp.Diagnostics, httpRes)
+		return
+	}
+
+	tflog.Trace(ctx, "deleted a resource")
+}
+
+func (r *UserResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	referenceType, organizationId, environmentId, domainId, userId, idErr := ParseUserID(req.ID)
+	if idErr != nil {
+		resp.Diagnostics.AddError(
+			"Error parsing id",
+			idErr.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("reference_type"), referenceType)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("organization_id"), organizationId)...)
+	if referenceType == "domain" {
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("environment_id"), environmentId)...)
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("domain_id"), domainId)...)
+	}
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("user_id"), userId)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("reset_password_on_change"), false)...)
+}
+
+// readUser returns a user, nil when it does not exist.
+func (r *UserResource) readUser(ctx context.Context, data userModel.UserResourceModel, diags *diag.Diagnostics) (*userModel.UserEntity, bool) {
+	var httpRes *http.Response
+	var err error
+	if data.ReferenceType.ValueString() == "domain" {
+		httpRes, err = r.client.DomainGetUser(ctx, data.OrganizationId.ValueString(), data.EnvironmentId.ValueString(), data.DomainId.ValueString(), data.UserId.ValueString())
+	} else {
+		httpRes, err = r.client.OrganizationGetPlatformUser(ctx, data.OrganizationId.ValueString(), data.UserId.ValueString())
+	}
+	if err != nil {
+		diags.AddError(
+			"Unable to read item",
+			err.Error(),
+		)
+		return nil, false
+	}
+	defer httpRes.Body.Close()
+
+	if httpRes.StatusCode == 404 {
+		return nil, true
+	}
+
+	if httpRes.StatusCode != 200 {
+		addAPIErrorDiagnostic(diags, httpRes)
+		return nil, false
+	}
+
+	var apiRes userModel.UserEntity
+	if err := json.NewDecoder(httpRes.Body).Decode(&apiRes); err != nil {
+		diags.AddError(
+			"Invalid format received",
+			err.Error(),
+		)
+		return nil, false
+	}
+	return &apiRes, true
+}
+
+// send applies a change to a user: its update when operation is empty,
+// otherwise its username, status or password.
+func (r *UserResource) send(ctx context.Context, data userModel.UserResourceModel, operation string, payload interface{}, diags *diag.Diagnostics) bool {
+	body, err := json.Marshal(payload)
+	if err != nil {
+		addErrorDiagnostic(diags, "Unable to update item", err)
+		return false
+	}
+
+	organizationId := data.OrganizationId.ValueString()
+	environmentId := data.EnvironmentId.ValueString()
+	domainId := data.DomainId.ValueString()
+	userId := data.UserId.ValueString()
+	domainUser := data.ReferenceType.ValueString() == "domain"
+
+	var httpRes *http.Response
+	switch {
+	case operation == "" && domainUser:
+		httpRes, err = r.client.DomainUpdateUserWithBody(ctx, organizationId, environmentId, domainId, userId, "application/json", bytes.NewReader(body))
+	case operation == "":
+		httpRes, err = r.client.OrganizationUpdatePlatformUserWithBody(ctx, organizationId, userId, "application/json", bytes.NewReader(body))
+	case operation == "username" && domainUser:
+		httpRes, err = r.client.EnvironmentResetDomainUserNameWithBody(ctx, organizationId, environmentId, domainId, userId, "application/json", bytes.NewReader(body))
+	case operation == "username":
+		httpRes, err = r.client.OrganizationUpdatePlatformUserNameWithBody(ctx, organizationId, userId, "application/json", bytes.NewReader(body))
+	case operation == "status" && domainUser:
+		httpRes, err = r.client.DomainUpdateUserStatusWithBody(ctx, organizationId, environmentId, domainId, userId, "application/json", bytes.NewReader(body))
+	case operation == "status":
+		httpRes, err = r.client.OrganizationUpdatePlatformUserStatusWithBody(ctx, organizationId, userId, "application/json", bytes.NewReader(body))
+	case domainUser:
+		httpRes, err = r.client.EnvironmentResetDomainUserPasswordWithBody(ctx, organizationId, environmentId, domainId, userId, "application/json", bytes.NewReader(body))
+	default:
+		httpRes, err = r.client.OrganizationResetPlatformUserPasswordWithBody(ctx, organizationId, userId, "application/json", bytes.NewReader(body))
+	}
+	if err != nil {
+		diags.AddError(
+			"Unable to update item",
+			err.Error(),
+		)
+		return false
+	}
+	defer httpRes.Body.Close()
+
+	if !isUpdateSuccess(httpRes) {
+		addAPIErrorDiagnostic(diags, httpRes)
+		return false
+	}
+	return true
+}
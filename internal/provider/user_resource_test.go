@@ -0,0 +1,83 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestParseUserID(t *testing.T) {
+	referenceType, organizationId, environmentId, domainId, userId, err := ParseUserID("DEFAULT:DEFAULT:domain:user")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if referenceType != "domain" || organizationId != "DEFAULT" || environmentId != "DEFAULT" || domainId != "domain" || userId != "user" {
+		t.Errorf("unexpected parts: %s, %s, %s, %s, %s", referenceType, organizationId, environmentId, domainId, userId)
+	}
+
+	for _, id := range []string{"", "DEFAULT", "DEFAULT:DEFAULT:domain", "DEFAULT:DEFAULT::user"} {
+		if _, _, _, _, _, err := ParseUserID(id); err == nil {
+			t.Errorf("expected an error for %q", id)
+		}
+	}
+}
+
+func TestAccUserResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + testAccUserResourceConfig("John", true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("graviteeioam_user.test", "username", "tf-acc-jdoe"),
+					resource.TestCheckResourceAttr("graviteeioam_user.test", "first_name", "John"),
+					resource.TestCheckResourceAttr("graviteeioam_user.test", "additional_information.team", "support"),
+					resource.TestCheckResourceAttr("graviteeioam_user.test", "enabled", "true"),
+					resource.TestCheckResourceAttrSet("graviteeioam_user.test", "source"),
+					resource.TestCheckResourceAttrSet("graviteeioam_user.test", "user_id"),
+				),
+			},
+			{
+				ResourceName:            "graviteeioam_user.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"password", "additional_information"},
+			},
+			{
+				Config: providerConfig + testAccUserResourceConfig("Jane", false),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("graviteeioam_user.test", "first_name", "Jane"),
+					resource.TestCheckResourceAttr("graviteeioam_user.test", "enabled", "false"),
+				),
+			},
+		},
+	})
+}
+
+func testAccUserResourceConfig(firstName string, enabled bool) string {
+	return fmt.Sprintf(`
+resource "graviteeioam_domain" "test" {
+  organization_id = "DEFAULT"
+  environment_id  = "DEFAULT"
+  name            = "tf-acc-user-domain"
+}
+
+resource "graviteeioam_user" "test" {
+  reference_type  = "domain"
+  organization_id = graviteeioam_domain.test.organization_id
+  environment_id  = graviteeioam_domain.test.environment_id
+  domain_id       = graviteeioam_domain.test.domain_id
+  username        = "tf-acc-jdoe"
+  email           = "tf-acc-jdoe@example.com"
+  first_name      = %[1]q
+  last_name       = "Doe"
+  password        = "Tf-acc-P4ssword!"
+  enabled         = %[2]t
+  additional_information = {
+    team = "support"
+  }
+}
+`, firstName, enabled)
+}